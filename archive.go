@@ -0,0 +1,323 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+
+	"github.com/gonejack/transcode/chardet"
+)
+
+// archiveKind returns zip, tar or tgz for the names of supported archives.
+func archiveKind(name string) string {
+	switch n := strings.ToLower(name); {
+	case strings.HasSuffix(n, ".zip"):
+		return "zip"
+	case strings.HasSuffix(n, ".tar"):
+		return "tar"
+	case strings.HasSuffix(n, ".tar.gz"), strings.HasSuffix(n, ".tgz"):
+		return "tgz"
+	}
+	return ""
+}
+
+// procArchive writes a copy of the archive with its text members converted,
+// binary members and metadata are kept as they are.
+func (c *trans) procArchive(f, kind string, src *os.File, out io.Writer) (err error) {
+	st, err := src.Stat()
+	if err != nil {
+		return fmt.Errorf("read file info failed: %w", err)
+	}
+	c.source = nil // detected per member
+	if !c.DetectEncoding && !strings.EqualFold(c.SourceEncoding, "auto") {
+		if c.source, err = parseEncoding(c.SourceEncoding); err != nil {
+			return fmt.Errorf("parse source-encoding %s failed: %w", c.SourceEncoding, err)
+		}
+	}
+	var tmp *os.File
+	switch {
+	case c.DetectEncoding:
+		out = io.Discard
+	case c.Overwrite:
+		if tmp, err = os.CreateTemp(os.TempDir(), "transcode.*."+kind); err != nil {
+			return
+		}
+		defer func() {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}()
+		out = tmp
+	}
+	var changed int
+	switch kind {
+	case "zip":
+		changed, err = c.convertZip(out, src, st.Size(), f)
+	default:
+		changed, err = c.convertTar(out, src, kind == "tgz", f)
+	}
+	if err != nil || tmp == nil {
+		return
+	}
+	if changed == 0 {
+		log.Printf("no changes, no member of archive %s needs conversion", f)
+		return
+	}
+	if err = src.Truncate(0); err == nil {
+		src.Seek(0, io.SeekStart)
+		tmp.Seek(0, io.SeekStart)
+		_, err = io.Copy(src, tmp)
+	}
+	return
+}
+
+// convertMember returns the converted content of an archive member, or nil to
+// keep it, like binary members and members already in the target encoding.
+func (c *trans) convertMember(archive, name string, dat []byte) []byte {
+	if len(dat) == 0 || looksBinary(dat) {
+		return nil
+	}
+	path := archive + ":" + name
+	srd := bufio.NewReader(bytes.NewReader(dat))
+	if c.DetectEncoding {
+		res, err := detectResult(srd, c.detectOptions()...)
+		printDetection(path, res, err)
+		return nil
+	}
+	source := c.source
+	if source == nil {
+		coding, err := detectEncoding(srd, c.detectOptions()...)
+		if err == nil {
+			source, err = parseEncoding(coding)
+		}
+		if err != nil {
+			log.Printf("kept %s unchanged, cannot determine source-encoding: %s", path, err)
+			return nil
+		}
+	}
+	var in io.Reader = srd
+	var fixed bool
+	if c.FixDeclaration {
+		in, fixed = c.fixDeclaration(path, srd)
+	}
+	if source == c.target && !fixed && c.Chinese == "" {
+		return nil
+	}
+	var dec transform.Transformer = source.NewDecoder()
+	if t := chineseTransformer(c.Chinese); t != nil {
+		dec = transform.Chain(dec, t)
+	}
+	var buf bytes.Buffer
+	if err := convertWith(&buf, in, dec, c.target); err != nil {
+		log.Printf("kept %s unchanged, convert failed: %s", path, err)
+		return nil
+	}
+	log.Printf("converted %s in %s from %s to %s", name, archive, source, c.target)
+	return buf.Bytes()
+}
+
+// looksBinary reports data with NUL bytes or many control characters, unless
+// it starts with a BOM or has the NUL pattern of UTF-16 or UTF-32 text.
+func looksBinary(dat []byte) bool {
+	hdr := dat[:min(len(dat), 2048)]
+	if _, n := chardet.SniffBOM(hdr); n > 0 {
+		return false
+	}
+	if _, err := chardet.DetectEncodingByNulPattern(hdr); err == nil {
+		return false
+	}
+	var ctrl int
+	for _, b := range hdr {
+		switch {
+		case b == 0:
+			return true
+		case b < 0x20 && b != '\t' && b != '\n' && b != '\r' && b != '\f' && b != 0x1b:
+			ctrl++
+		}
+	}
+	return ctrl*10 > len(hdr)
+}
+
+func (c *trans) convertZip(w io.Writer, r io.ReaderAt, size int64, archive string) (changed int, err error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return
+	}
+	names, err := c.zipNameEncoding(zr.File)
+	if err != nil {
+		return
+	}
+	zw := zip.NewWriter(w)
+	if err = zw.SetComment(zr.Comment); err != nil {
+		return
+	}
+	for _, f := range zr.File {
+		hdr := f.FileHeader
+		if names != nil && hdr.Flags&0x800 == 0 && !isASCII(hdr.Name+hdr.Comment) {
+			name, exx := names.NewDecoder().String(hdr.Name)
+			comment, exy := names.NewDecoder().String(hdr.Comment)
+			if exx == nil && exy == nil {
+				log.Printf("renamed %q in %s to %s", hdr.Name, archive, name)
+				hdr.Name, hdr.Comment, hdr.NonUTF8 = name, comment, false
+				hdr.Flags |= 0x800
+				changed++
+			}
+		}
+		var conv []byte
+		if !f.FileInfo().IsDir() {
+			rc, exx := f.Open()
+			if exx != nil {
+				return changed, fmt.Errorf("open %s failed: %w", f.Name, exx)
+			}
+			dat, exx := io.ReadAll(rc)
+			rc.Close()
+			if exx != nil {
+				return changed, fmt.Errorf("read %s failed: %w", f.Name, exx)
+			}
+			conv = c.convertMember(archive, hdr.Name, dat)
+		}
+		if conv == nil {
+			if err = copyZipRaw(zw, f, &hdr); err != nil {
+				return
+			}
+			continue
+		}
+		changed++
+		hdr.CRC32, hdr.CompressedSize64, hdr.UncompressedSize64 = 0, 0, uint64(len(conv))
+		hdr.Extra = stripZipExtra(hdr.Extra)
+		fw, exx := zw.CreateHeader(&hdr)
+		if exx != nil {
+			return changed, exx
+		}
+		if _, err = fw.Write(conv); err != nil {
+			return
+		}
+	}
+	return changed, zw.Close()
+}
+
+func copyZipRaw(zw *zip.Writer, f *zip.File, hdr *zip.FileHeader) error {
+	raw, err := f.OpenRaw()
+	if err != nil {
+		return err
+	}
+	fw, err := zw.CreateRaw(hdr)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, raw)
+	return err
+}
+
+// stripZipExtra removes the zip64 and timestamp fields, the writer adds them
+// again for the new content.
+func stripZipExtra(extra []byte) (kept []byte) {
+	for len(extra) >= 4 {
+		id, n := binary.LittleEndian.Uint16(extra), int(binary.LittleEndian.Uint16(extra[2:]))
+		if len(extra) < 4+n {
+			break
+		}
+		if id != 0x0001 && id != 0x5455 {
+			kept = append(kept, extra[:4+n]...)
+		}
+		extra = extra[4+n:]
+	}
+	return
+}
+
+// zipNameEncodings are the code pages archivers wrote names in besides CP437,
+// other detected encodings are taken for misdetected CP437.
+var zipNameEncodings = []string{"gbk", "gb18030", "big5", "big5-hkscs", "shift_jis", "euc-kr", "ibm866", "ibm850"}
+
+// zipNameEncoding returns the encoding set by --zip-names for entry names
+// without the UTF-8 flag, nil when there are none. Auto detects it over all
+// names, falling back to CP437 of the zip specification.
+func (c *trans) zipNameEncoding(files []*zip.File) (encoding.Encoding, error) {
+	if c.ZipNames == "" {
+		return nil, nil
+	}
+	var names []byte
+	for _, f := range files {
+		if f.Flags&0x800 == 0 && !isASCII(f.Name) {
+			names = append(names, f.Name+"\n"...)
+		}
+	}
+	switch {
+	case len(names) == 0:
+		return nil, nil
+	case !strings.EqualFold(c.ZipNames, "auto"):
+		enc, err := parseEncoding(c.ZipNames)
+		if err != nil {
+			return nil, fmt.Errorf("parse zip-names %s failed: %w", c.ZipNames, err)
+		}
+		return enc, nil
+	case utf8.Valid(names):
+		return unicode.UTF8, nil // only the flag is missing
+	}
+	if coding, err := chardet.DetectEncoding(names, c.detectOptions()...); err == nil {
+		for _, name := range chardet.Supersets(coding) {
+			if slices.Contains(zipNameEncodings, name) {
+				return parseEncoding(name)
+			}
+		}
+	}
+	return charmap.CodePage437, nil
+}
+
+func (c *trans) convertTar(w io.Writer, r io.Reader, compressed bool, archive string) (changed int, err error) {
+	var gw *gzip.Writer
+	if compressed {
+		gr, exx := gzip.NewReader(r)
+		if exx != nil {
+			return 0, exx
+		}
+		defer gr.Close()
+		gw = gzip.NewWriter(w)
+		gw.Header = gr.Header
+		r, w = gr, gw
+	}
+	tr, tw := tar.NewReader(r), tar.NewWriter(w)
+	for {
+		hdr, exx := tr.Next()
+		if exx == io.EOF {
+			break
+		}
+		if exx != nil {
+			return changed, exx
+		}
+		var dat []byte
+		if hdr.Typeflag == tar.TypeReg {
+			if dat, err = io.ReadAll(tr); err != nil {
+				return changed, fmt.Errorf("read %s failed: %w", hdr.Name, err)
+			}
+			if conv := c.convertMember(archive, hdr.Name, dat); conv != nil {
+				dat, hdr.Size = conv, int64(len(conv))
+				changed++
+			}
+		}
+		if err = tw.WriteHeader(hdr); err != nil {
+			return
+		}
+		if _, err = tw.Write(dat); err != nil {
+			return
+		}
+	}
+	if err = tw.Close(); err == nil && gw != nil {
+		err = gw.Close()
+	}
+	return
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+var binaryMember = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01")
+
+func readCorpus(t *testing.T, name string) (dat []byte, text string) {
+	t.Helper()
+	dat, err := os.ReadFile(filepath.Join(corpusDir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := parseEncoding(filepath.Dir(name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	utf8, err := enc.NewDecoder().Bytes(dat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dat, string(utf8)
+}
+
+func TestArchiveZip(t *testing.T) {
+	quietLog(t)
+	gbk, text := readCorpus(t, "gbk/zh-medium.txt")
+	legacy, _ := simplifiedchinese.GBK.NewEncoder().String("说明.txt")
+	modified := time.Date(2020, 1, 2, 3, 4, 6, 0, time.UTC)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	add := func(hdr *zip.FileHeader, dat []byte) {
+		hdr.Modified = modified
+		w, err := zw.CreateHeader(hdr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(dat)
+	}
+	add(&zip.FileHeader{Name: "docs/", Method: zip.Store}, nil)
+	add(&zip.FileHeader{Name: "docs/readme.txt", Method: zip.Deflate, Comment: "note"}, gbk)
+	add(&zip.FileHeader{Name: "image.png", Method: zip.Store}, binaryMember)
+	add(&zip.FileHeader{Name: legacy, Method: zip.Deflate, NonUTF8: true}, []byte("ascii\n"))
+	zw.SetComment("archive comment")
+	zw.Close()
+	f := filepath.Join(t.TempDir(), "docs.zip")
+	if err := os.WriteFile(f, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := transcode(t, nil, "--zip-names", "gbk", f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(out), int64(len(out)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zr.Comment != "archive comment" {
+		t.Errorf("archive comment %q", zr.Comment)
+	}
+	want := []struct {
+		name    string
+		method  uint16
+		content string
+	}{
+		{"docs/", zip.Store, ""},
+		{"docs/readme.txt", zip.Deflate, text},
+		{"image.png", zip.Store, string(binaryMember)},
+		{"说明.txt", zip.Deflate, "ascii\n"},
+	}
+	if len(zr.File) != len(want) {
+		t.Fatalf("got %d members, want %d", len(zr.File), len(want))
+	}
+	for i, w := range want {
+		m := zr.File[i]
+		rc, err := m.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(rc)
+		rc.Close()
+		switch {
+		case err != nil:
+			t.Errorf("%s: %s", w.name, err)
+		case m.Name != w.name || m.NonUTF8:
+			t.Errorf("member %d is named %q (non-UTF-8 %v), want %q", i, m.Name, m.NonUTF8, w.name)
+		case m.Method != w.method:
+			t.Errorf("%s: method %d, want %d", w.name, m.Method, w.method)
+		case !m.Modified.Equal(modified):
+			t.Errorf("%s: modified %s, want %s", w.name, m.Modified, modified)
+		case string(got) != w.content:
+			t.Errorf("%s: got %q, want %q", w.name, got, w.content)
+		}
+	}
+	if zr.File[1].Comment != "note" {
+		t.Errorf("member comment %q", zr.File[1].Comment)
+	}
+}
+
+func TestArchiveTarGz(t *testing.T) {
+	quietLog(t)
+	cyrillic, text := readCorpus(t, "koi8-r/ru-long.txt")
+	modified := time.Date(2021, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	gw.Name = "notes.tar"
+	tw := tar.NewWriter(gw)
+	add := func(hdr *tar.Header, dat []byte) {
+		hdr.ModTime, hdr.Size = modified, int64(len(dat))
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		tw.Write(dat)
+	}
+	add(&tar.Header{Name: "notes/", Typeflag: tar.TypeDir, Mode: 0755}, nil)
+	add(&tar.Header{Name: "notes/ru.txt", Typeflag: tar.TypeReg, Mode: 0600, Uname: "alice"}, cyrillic)
+	add(&tar.Header{Name: "notes/image.png", Typeflag: tar.TypeReg, Mode: 0644}, binaryMember)
+	add(&tar.Header{Name: "notes/latest.txt", Typeflag: tar.TypeSymlink, Linkname: "ru.txt"}, nil)
+	tw.Close()
+	gw.Close()
+	f := filepath.Join(t.TempDir(), "notes.tar.gz")
+	if err := os.WriteFile(f, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := transcode(t, nil, "-w", f); err != nil {
+		t.Fatal(err)
+	}
+	dat, err := os.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(dat))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gr.Name != "notes.tar" {
+		t.Errorf("gzip name %q", gr.Name)
+	}
+	tr := tar.NewReader(gr)
+	want := []struct {
+		name    string
+		mode    int64
+		content string
+	}{
+		{"notes/", 0755, ""},
+		{"notes/ru.txt", 0600, text},
+		{"notes/image.png", 0644, string(binaryMember)},
+		{"notes/latest.txt", 0, ""},
+	}
+	for _, w := range want {
+		hdr, err := tr.Next()
+		if err != nil {
+			t.Fatalf("%s: %s", w.name, err)
+		}
+		got, _ := io.ReadAll(tr)
+		switch {
+		case hdr.Name != w.name:
+			t.Errorf("got member %s, want %s", hdr.Name, w.name)
+		case hdr.Mode != w.mode || !hdr.ModTime.Equal(modified):
+			t.Errorf("%s: mode %o modified %s", w.name, hdr.Mode, hdr.ModTime)
+		case string(got) != w.content:
+			t.Errorf("%s: got %q, want %q", w.name, got, w.content)
+		}
+	}
+	if _, err := tr.Next(); err != io.EOF {
+		t.Errorf("got %v after the last member, want EOF", err)
+	}
+
+	// converted again, nothing changes
+	if _, err := transcode(t, nil, "-w", f); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := os.ReadFile(f); !bytes.Equal(again, dat) {
+		t.Error("archive rewritten without changes")
+	}
+}
+
+func TestArchiveDetect(t *testing.T) {
+	quietLog(t)
+	gbk, _ := readCorpus(t, "gbk/zh-medium.txt")
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, dat := range map[string][]byte{"zh.txt": gbk, "image.png": binaryMember} {
+		tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(dat))})
+		tw.Write(dat)
+	}
+	tw.Close()
+	f := filepath.Join(t.TempDir(), "docs.tar")
+	if err := os.WriteFile(f, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := transcode(t, nil, "-d", f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "encoding of file " + f + ":zh.txt is gb2312"; !strings.HasPrefix(string(out), want) || strings.Count(string(out), "\n") != 1 {
+		t.Errorf("got %q, want a line %q", out, want)
+	}
+}
+
+func TestZipNameEncodingAuto(t *testing.T) {
+	var names []string
+	for _, s := range []string{"会议纪要.txt", "财务报表/", "财务报表/第一季度.csv", "项目计划书.doc"} {
+		n, _ := simplifiedchinese.GBK.NewEncoder().String(s)
+		names = append(names, n)
+	}
+	tests := []struct {
+		names []string
+		flag  uint16
+		want  encoding.Encoding
+	}{
+		{names, 0, simplifiedchinese.GB18030},
+		{[]string{"说明.txt"}, 0, unicode.UTF8},
+		{[]string{"Gr\x94\xe1e.txt", "\x9abersicht.txt"}, 0, charmap.CodePage437},
+		{names, 0x800, nil},
+		{[]string{"readme.txt"}, 0, nil},
+	}
+	c := &trans{options: options{ZipNames: "auto"}}
+	for _, tt := range tests {
+		var files []*zip.File
+		for _, n := range tt.names {
+			files = append(files, &zip.File{FileHeader: zip.FileHeader{Name: n, Flags: tt.flag}})
+		}
+		enc, err := c.zipNameEncoding(files)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enc != tt.want {
+			t.Errorf("zipNameEncoding(%q) = %v, want %v", tt.names, enc, tt.want)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gonejack/transcode/chardet"
+)
+
+// benchDetect measures how well every detection backend recognizes the files
+// of a corpus, a directory with a subdirectory of samples per encoding.
+type benchDetect struct {
+	Corpus string `name:"corpus" type:"existingdir" default:"testfiles/corpus" help:"Corpus directory, with a subdirectory named by encoding for each set of samples."`
+	Format string `name:"format" enum:"text,json" default:"text" help:"Set output format, one of text,json."`
+	Peek   int    `name:"peek" default:"2048" help:"Bytes of each file passed to the detectors, as when converting."`
+}
+
+type benchSample struct {
+	file     string
+	encoding string
+	data     []byte
+}
+
+type benchConfusion struct {
+	Expected string `json:"expected"`
+	Detected string `json:"detected"`
+	Count    int    `json:"count"`
+}
+
+type benchResult struct {
+	Backend    string           `json:"backend"`
+	Samples    int              `json:"samples"`
+	Correct    int              `json:"correct"`
+	Failed     int              `json:"failed"`
+	Accuracy   float64          `json:"accuracy"`
+	Latency    time.Duration    `json:"mean_latency_ns"`
+	Confusions []benchConfusion `json:"confusions,omitempty"`
+}
+
+func (c *benchDetect) Run() error {
+	samples, err := c.load()
+	if err != nil {
+		return err
+	}
+	backends := append([]chardet.Backend{{Name: "pipeline", Detect: func(dat []byte) (string, error) {
+		return chardet.DetectEncoding(dat)
+	}}}, chardet.Backends()...)
+	results := make([]benchResult, len(backends))
+	for i, b := range backends {
+		results[i] = benchBackend(b, samples)
+	}
+	if c.Format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+	}
+	return printBench(os.Stdout, results)
+}
+
+func (c *benchDetect) load() (samples []benchSample, err error) {
+	dirs, err := os.ReadDir(c.Corpus)
+	if err != nil {
+		return
+	}
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		if _, ok := lookupEncoding(d.Name()); !ok {
+			return nil, fmt.Errorf("corpus directory %s is not named by an encoding", d.Name())
+		}
+		files, err := filepath.Glob(filepath.Join(c.Corpus, d.Name(), "*"))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			dat, err := os.ReadFile(f)
+			if err != nil {
+				return nil, err
+			}
+			if len(dat) > c.Peek {
+				dat = dat[:c.Peek]
+			}
+			samples = append(samples, benchSample{f, d.Name(), dat})
+		}
+	}
+	if len(samples) == 0 {
+		err = fmt.Errorf("no samples in corpus %s", c.Corpus)
+	}
+	return
+}
+
+func benchBackend(b chardet.Backend, samples []benchSample) benchResult {
+	r := benchResult{Backend: b.Name, Samples: len(samples)}
+	confusions := make(map[[2]string]int)
+	var elapsed time.Duration
+	for _, s := range samples {
+		start := time.Now()
+		got, err := b.Detect(s.data)
+		elapsed += time.Since(start)
+		switch {
+		case err != nil:
+			r.Failed++
+			confusions[[2]string{s.encoding, "(none)"}]++
+		case chardet.SameText(s.encoding, got, s.data):
+			r.Correct++
+		default:
+			confusions[[2]string{s.encoding, chardet.Canonical(got)}]++
+		}
+	}
+	r.Accuracy = float64(r.Correct) / float64(r.Samples)
+	r.Latency = elapsed / time.Duration(r.Samples)
+	for k, n := range confusions {
+		r.Confusions = append(r.Confusions, benchConfusion{k[0], k[1], n})
+	}
+	slices.SortFunc(r.Confusions, func(a, b benchConfusion) int {
+		return cmp.Or(b.Count-a.Count, strings.Compare(a.Expected, b.Expected), strings.Compare(a.Detected, b.Detected))
+	})
+	return r
+}
+
+func printBench(w io.Writer, results []benchResult) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BACKEND\tACCURACY\tCORRECT\tFAILED\tMEAN LATENCY")
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%.1f%%\t%d/%d\t%d\t%s\n", r.Backend, 100*r.Accuracy, r.Correct, r.Samples, r.Failed, r.Latency)
+	}
+	for _, r := range results {
+		if len(r.Confusions) == 0 {
+			continue
+		}
+		fmt.Fprintf(tw, "\nconfusions of %s:\n", r.Backend)
+		fmt.Fprintln(tw, "  EXPECTED\tDETECTED\tCOUNT")
+		for _, c := range r.Confusions {
+			fmt.Fprintf(tw, "  %s\t%s\t%d\n", c.Expected, c.Detected, c.Count)
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBenchDetect(t *testing.T) {
+	quietLog(t)
+	dir := t.TempDir()
+	// the windows-1251 sample is UTF-8, every backend should get it wrong
+	for name, text := range map[string]string{
+		"utf-8":        sampleText(t, "gb18030"),
+		"windows-1251": sampleText(t, "koi8-r"),
+	} {
+		if err := os.Mkdir(filepath.Join(dir, name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name, "sample.txt"), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out, err := transcode(t, nil, "bench-detect", "--corpus", dir, "--format", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []benchResult
+	if err = json.Unmarshal(out, &results); err != nil {
+		t.Fatal(err)
+	}
+	if len(results) == 0 || results[0].Backend != "pipeline" {
+		t.Fatalf("bench-detect results = %s", out)
+	}
+	want := []benchConfusion{{"windows-1251", "utf-8", 1}}
+	if r := results[0]; r.Samples != 2 || r.Correct != 1 || r.Failed != 0 || r.Accuracy != 0.5 || !slices.Equal(r.Confusions, want) {
+		t.Errorf("bench-detect pipeline = %+v, want 1 of 2 correct and confusions %v", r, want)
+	}
+	for _, r := range results {
+		if r.Samples != 2 || r.Correct > 1 {
+			t.Errorf("bench-detect %s = %+v, want 2 samples and at most 1 correct", r.Backend, r)
+		}
+	}
+
+	out, err = transcode(t, nil, "bench-detect", "--corpus", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(out), "\n")
+	if got := strings.Fields(lines[1]); len(got) < 4 || !slices.Equal(got[:4], []string{"pipeline", "50.0%", "1/2", "0"}) {
+		t.Errorf("bench-detect text row = %q", lines[1])
+	}
+	if i := slices.Index(lines, "confusions of pipeline:"); i < 0 || !slices.Equal(strings.Fields(lines[i+2]), []string{"windows-1251", "utf-8", "1"}) {
+		t.Errorf("bench-detect text misses the confusions of pipeline:\n%s", out)
+	}
+}
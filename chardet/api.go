@@ -1,23 +1,60 @@
 package chardet
 
 import (
-	"bytes"
+	"errors"
+	"fmt"
 	"slices"
-
-	"github.com/wlynxg/chardet/consts"
 )
 
 type detectFunc func([]byte) (string, error)
 
-func prefer(f detectFunc) {
-	detectFuncList = slices.Insert(detectFuncList, 0, f)
+// limitFunc is a detectFunc that only answers one of the candidates.
+type limitFunc func(dat []byte, candidates []string) (string, error)
+
+// backend is a detector, limit is nil when it cannot take a candidate set
+// and its answers are filtered afterwards.
+type backend struct {
+	name   string
+	detect detectFunc
+	limit  limitFunc
+}
+
+// recoverBackend turns a panic of a backend on malformed input, like the
+// index out of range of wlynxg on a lone 0xE9 byte, into an error.
+func recoverBackend(err *error, name string) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("detect failed by %s: %v", name, r)
+	}
+}
+
+func prefer(name string, f detectFunc) {
+	preferLimited(name, f, nil)
+}
+func preferLimited(name string, f detectFunc, limit limitFunc) {
+	detectFuncList = slices.Insert(detectFuncList, 0, backend{name, f, limit})
+}
+
+var detectFuncList = []backend{
+	//{"uchardet-dylib", DetectEncodingByUChardetDylib, nil},
+	{"uchardet-cmd", DetectEncodingByUChardetCmd, nil},
+	{"wlynxg", DetectEncodingByWlynxgChardet, DetectEncodingByWlynxgChardetOnly},
+	{"gogs", DetectEncodingByGogsChardet, DetectEncodingByGogsChardetOnly},
 }
 
-var detectFuncList = []detectFunc{
-	//DetectEncodingByUChardetDylib,
-	DetectEncodingByUChardetCmd,
-	DetectEncodingByWlynxgChardet,
-	DetectEncodingByGogsChardet,
+// Backend is one of the detectors DetectEncoding consults in order.
+type Backend struct {
+	Name   string
+	Detect func([]byte) (string, error)
+}
+
+// Backends returns the detectors compiled in, in the order DetectEncoding
+// tries them.
+func Backends() []Backend {
+	list := make([]Backend, len(detectFuncList))
+	for i, b := range detectFuncList {
+		list[i] = Backend{b.name, b.detect}
+	}
+	return list
 }
 
 const (
@@ -26,26 +63,107 @@ const (
 	UTF16BEWithBOM string = "utf-16be-bom"
 	UTF32LEWithBOM string = "utf-32le-bom"
 	UTF32BEWithBOM string = "utf-32be-bom"
+	UTF7WithBOM    string = "utf-7-bom"
+	GB18030WithBOM string = "gb18030-bom"
 )
 
-func DetectEncoding(dat []byte) (v string, err error) {
-	switch {
-	case bytes.HasPrefix(dat, []byte(consts.UTF8BOM)):
-		return UTF8WithBOM, nil // EF BB BF  UTF-8 with BOM
-	case bytes.HasPrefix(dat, []byte(consts.UTF16LEBOM)):
-		return UTF16LEWithBOM, nil // FF FE  UTF-16, little endian BOM
-	case bytes.HasPrefix(dat, []byte(consts.UTF16BEBOM)):
-		return UTF16BEWithBOM, nil // FE FF  UTF-16, big endian BOM
-	case bytes.HasPrefix(dat, []byte(consts.UTF32LEBOM)):
-		return UTF32LEWithBOM, nil // FF FE 00 00  UTF-32, little-endian BOM
-	case bytes.HasPrefix(dat, []byte(consts.UTF32BEBOM)):
-		return UTF32BEWithBOM, nil // 00 00 FE FF  UTF-32, big-endian BOM
-	}
-	for _, f := range detectFuncList {
-		v, err = f(dat)
+type config struct {
+	trust  Trust
+	lang   *language
+	prefer []string
+	only   []string
+	widest bool
+}
+
+// Option configures DetectEncoding.
+type Option func(*config)
+
+// TrustDeclared sets the policy for in-band charset declarations, the
+// default is TrustVerify.
+func TrustDeclared(t Trust) Option {
+	return func(c *config) {
+		c.trust = t
+	}
+}
+
+func newConfig(opts []Option) *config {
+	cfg := &config{trust: TrustVerify}
+	for _, o := range opts {
+		o(cfg)
+	}
+	return cfg
+}
+
+// DetectEncoding returns the Canonical name of the encoding of dat.
+func DetectEncoding(dat []byte, opts ...Option) (v string, err error) {
+	return newConfig(opts).detect(dat)
+}
+
+// Detect is DetectEncoding that also identifies the language and script of
+// the text.
+func Detect(dat []byte, opts ...Option) (r Result, err error) {
+	cfg := newConfig(opts)
+	r.Encoding, err = cfg.detect(dat)
+	if err == nil {
+		r.Language, r.Script = identify(dat, r.Encoding, cfg.lang)
+	}
+	return
+}
+
+func (cfg *config) detect(dat []byte) (v string, err error) {
+	v, err = cfg.detectRaw(dat)
+	if err != nil {
+		return
+	}
+	if v = Canonical(v); cfg.widest {
+		v = Widest(v)
+	}
+	return
+}
+
+func (cfg *config) detectRaw(dat []byte) (v string, err error) {
+	if v, n := SniffBOM(dat); n > 0 {
+		if slices.Contains(undecodable, v) {
+			return "", fmt.Errorf("%w %s, announced by its signature", ErrUnsupported, v)
+		}
+		if v, ok := cfg.restrict(v); ok {
+			return v, nil
+		}
+	}
+	if v, ok := DetectEncodingByDeclaration(dat, cfg.trust); ok {
+		if v, ok = cfg.restrict(v); ok {
+			return v, nil
+		}
+	}
+	if v, err = DetectEncodingByNulPattern(dat); err == nil {
+		if v, ok := cfg.restrict(v); ok {
+			return v, nil
+		}
+	}
+	for _, b := range detectFuncList {
+		if b.limit != nil && cfg.only != nil {
+			v, err = b.limit(dat, cfg.only)
+		} else {
+			v, err = b.detect(dat)
+		}
+		if err == nil {
+			var ok bool
+			if v, ok = cfg.restrict(v); !ok {
+				err = errors.New("no candidate detected")
+			}
+		}
 		if err == nil {
 			break
 		}
 	}
+	if err != nil && cfg.only != nil {
+		v, err = fittingCandidate(dat, cfg.only)
+	}
+	if err == nil && cfg.lang != nil {
+		v = preferLanguage(dat, v, cfg.lang)
+	}
+	if err == nil && cfg.prefer != nil {
+		v = preferCandidate(dat, v, cfg.prefer)
+	}
 	return
 }
@@ -2,23 +2,38 @@ package chardet
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
 )
 
+const corpus = "../testfiles/corpus"
+
 var cases = []struct {
 	file string
 	want string
 }{
-	{"../testfiles/野草utf8.txt", "utf-8"},
-	{"../testfiles/test.txt", UTF16LEWithBOM},
-	{"../testfiles/abc.txt", "gb2312"},
-	{"../testfiles/gb18030.txt", "gb2312"},
-	{"../testfiles/hello.txt", "cp866"},
+	{"utf-8/zh-medium.txt", "utf-8"},
+	{"utf-16le-bom/en-short.txt", UTF16LEWithBOM},
+	{"utf-32le/ru-medium.txt", "utf-32le"},
+	{"gbk/zh-medium.txt", "gb2312"},
+	{"gb18030/zh-long.txt", "gb2312"},
+	{"big5/zh-Hant-long.txt", "big5"},
+	{"shift_jis/ja-long.txt", "shift_jis"},
+	{"euc-kr/ko-long.txt", "euc-kr"},
+	{"ibm866/ru-long.txt", "ibm866"},
+	{"koi8-r/ru-long.txt", "koi8-r"},
+	{"iso-8859-7/el-long.txt", "iso-8859-7"},
+	{"windows-1255/he-long.txt", "windows-1255"},
 }
 
 func TestDetectEncoding(t *testing.T) {
 	for _, c := range cases {
-		dat, err := os.ReadFile(c.file)
+		dat, err := os.ReadFile(filepath.Join(corpus, c.file))
 		if err != nil {
 			t.Fatalf("read %s: %v", c.file, err)
 		}
@@ -32,3 +47,64 @@ func TestDetectEncoding(t *testing.T) {
 		}
 	}
 }
+
+func TestDetectEncodingByNulPattern(t *testing.T) {
+	const text = "Hello, 世界! Привет 😀\r\n"
+	var cases = []struct {
+		enc  encoding.Encoding
+		want string
+	}{
+		{unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), "utf-16le"},
+		{unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), "utf-16be"},
+		{utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM), "utf-32le"},
+		{utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM), "utf-32be"},
+	}
+	for _, c := range cases {
+		dat, _ := c.enc.NewEncoder().Bytes([]byte(strings.Repeat(text, 100)))
+		for _, n := range []int{len(dat), 2047} {
+			got, err := DetectEncoding(dat[:n])
+			if err != nil || got != c.want {
+				t.Errorf("%d bytes of %s: got %q (%v)", n, c.want, got, err)
+			}
+		}
+	}
+	for _, s := range []string{"plain ascii text", "中文 gb18030 \xd6\xd0\xce\xc4", "ab\x00"} {
+		if got, err := DetectEncodingByNulPattern([]byte(s)); err == nil {
+			t.Errorf("%q: got %q", s, got)
+		}
+	}
+}
+
+// BenchmarkBackends runs every backend over the corpus and reports the share
+// of files it recognizes, answers that decode to the same text count.
+func BenchmarkBackends(b *testing.B) {
+	files, err := filepath.Glob(filepath.Join(corpus, "*", "*.txt"))
+	if err != nil || len(files) == 0 {
+		b.Fatalf("no corpus files: %v", err)
+	}
+	type sample struct {
+		encoding string
+		data     []byte
+	}
+	samples := make([]sample, len(files))
+	for i, f := range files {
+		dat, err := os.ReadFile(f)
+		if err != nil {
+			b.Fatal(err)
+		}
+		samples[i] = sample{filepath.Base(filepath.Dir(f)), dat[:min(len(dat), 2048)]}
+	}
+	pipeline := Backend{"pipeline", func(dat []byte) (string, error) { return DetectEncoding(dat) }}
+	for _, be := range append([]Backend{pipeline}, Backends()...) {
+		b.Run(be.Name, func(b *testing.B) {
+			correct := 0
+			for i := 0; i < b.N; i++ {
+				s := samples[i%len(samples)]
+				if v, err := be.Detect(s.data); err == nil && SameText(s.encoding, v, s.data) {
+					correct++
+				}
+			}
+			b.ReportMetric(float64(correct)/float64(b.N), "accuracy")
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package chardet
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"slices"
+)
+
+type signature struct {
+	sig  []byte
+	name string
+}
+
+// signatures maps byte order marks and encoding signatures to encoding
+// names. init sorts it longest first so SniffBOM reports the longest match,
+// e.g. FF FE 00 00 is UTF-32LE rather than UTF-16LE followed by NUL.
+var signatures = []signature{
+	{[]byte{0xEF, 0xBB, 0xBF}, UTF8WithBOM},
+	{[]byte{0xFF, 0xFE}, UTF16LEWithBOM},
+	{[]byte{0xFE, 0xFF}, UTF16BEWithBOM},
+	{[]byte{0xFF, 0xFE, 0x00, 0x00}, UTF32LEWithBOM},
+	{[]byte{0x00, 0x00, 0xFE, 0xFF}, UTF32BEWithBOM},
+	// U+FEFF in UTF-7; the last base64 digit already carries bits of the
+	// next character unless the run is closed by '-'.
+	{[]byte("+/v8-"), UTF7WithBOM},
+	{[]byte("+/v8"), UTF7WithBOM},
+	{[]byte("+/v9"), UTF7WithBOM},
+	{[]byte("+/v+"), UTF7WithBOM},
+	{[]byte("+/v/"), UTF7WithBOM},
+	{[]byte{0xDD, 0x73, 0x66, 0x73}, "utf-ebcdic"},
+	{[]byte{0x84, 0x31, 0x95, 0x33}, GB18030WithBOM},
+	{[]byte{0x0E, 0xFE, 0xFF}, "scsu"},
+	{[]byte{0xFB, 0xEE, 0x28, 0xFF}, "bocu-1"},
+	{[]byte{0xFB, 0xEE, 0x28}, "bocu-1"},
+}
+
+// undecodable lists the encodings of signatures that no decoder is available
+// for. Detecting them is certain, so other detectors are not asked.
+var undecodable = []string{"utf-ebcdic", "scsu", "bocu-1"}
+
+// ErrUnsupported is returned for input in an encoding that is recognized but
+// cannot be decoded.
+var ErrUnsupported = errors.New("unsupported encoding")
+
+func init() {
+	slices.SortStableFunc(signatures, func(a, b signature) int {
+		return cmp.Compare(len(b.sig), len(a.sig))
+	})
+}
+
+// SniffBOM reports the encoding announced by a byte order mark or signature
+// at the start of dat and the signature's length in bytes, or "", 0 when
+// there is none.
+func SniffBOM(dat []byte) (name string, length int) {
+	for _, s := range signatures {
+		if bytes.HasPrefix(dat, s.sig) {
+			return s.name, len(s.sig)
+		}
+	}
+	return "", 0
+}
@@ -0,0 +1,66 @@
+package chardet
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSniffBOM(t *testing.T) {
+	var cases = []struct {
+		dat    string
+		name   string
+		length int
+	}{
+		{"", "", 0},
+		{"plain", "", 0},
+		{"\xEF\xBB\xBFabc", UTF8WithBOM, 3},
+		{"\xEF\xBB", "", 0},
+		{"\xFF\xFEa\x00", UTF16LEWithBOM, 2},
+		{"\xFF\xFE", UTF16LEWithBOM, 2},
+		{"\xFF\xFE\x00", UTF16LEWithBOM, 2},
+		{"\xFE\xFF\x00a", UTF16BEWithBOM, 2},
+		{"\xFF\xFE\x00\x00", UTF32LEWithBOM, 4},
+		{"\xFF\xFE\x00\x00a\x00\x00\x00", UTF32LEWithBOM, 4},
+		{"\x00\x00\xFE\xFF\x00\x00\x00a", UTF32BEWithBOM, 4},
+		{"\x00\x00\xFE", "", 0},
+		{"+/v8-Hi", UTF7WithBOM, 5},
+		{"+/v8AGE-", UTF7WithBOM, 4},
+		{"+/v9AGE-", UTF7WithBOM, 4},
+		{"+/v+AGE-", UTF7WithBOM, 4},
+		{"+/v/AGE-", UTF7WithBOM, 4},
+		{"+/vA", "", 0},
+		{"\xDD\x73\x66\x73\xC1", "utf-ebcdic", 4},
+		{"\x84\x31\x95\x33\xD6\xD0", GB18030WithBOM, 4},
+		{"\x84\x31\x95", "", 0},
+		{"\x0E\xFE\xFFabc", "scsu", 3},
+		{"\xFB\xEE\x28\xFFabc", "bocu-1", 4},
+		{"\xFB\xEE\x28abc", "bocu-1", 3},
+	}
+	for _, c := range cases {
+		name, length := SniffBOM([]byte(c.dat))
+		if name != c.name || length != c.length {
+			t.Errorf("SniffBOM(% X) = %q, %d, want %q, %d", c.dat, name, length, c.name, c.length)
+		}
+		switch {
+		case slices.Contains(undecodable, c.name):
+			if got, err := DetectEncoding([]byte(c.dat)); !errors.Is(err, ErrUnsupported) {
+				t.Errorf("DetectEncoding(% X) = %q, %v, want ErrUnsupported", c.dat, got, err)
+			}
+		case c.length > 0:
+			if got, err := DetectEncoding([]byte(c.dat)); err != nil || got != c.name {
+				t.Errorf("DetectEncoding(% X) = %q, %v, want %q", c.dat, got, err, c.name)
+			}
+		}
+	}
+}
+
+// TestSignatureOrder checks that no signature is shadowed by a shorter one
+// that is its prefix.
+func TestSignatureOrder(t *testing.T) {
+	for _, s := range signatures {
+		if name, length := SniffBOM(s.sig); name != s.name || length != len(s.sig) {
+			t.Errorf("signature % X of %s matches %s with length %d", s.sig, s.name, name, length)
+		}
+	}
+}
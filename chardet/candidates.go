@@ -0,0 +1,96 @@
+package chardet
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Prefer lists encodings expected for the input in order. When the detected
+// encoding is not one of them, the first one that decodes the input cleanly
+// is taken instead, so a short gb18030 text is not reported as iso-8859-1.
+func Prefer(names ...string) Option {
+	return func(c *config) {
+		c.prefer = candidates(names)
+	}
+}
+
+// Only restricts detection to the given encodings. Backends that take a
+// candidate set are limited to it, answers of the others are dropped when
+// they are not a candidate. Without an answer, the first candidate that
+// decodes the input cleanly is taken.
+func Only(names ...string) Option {
+	return func(c *config) {
+		c.only = candidates(names)
+	}
+}
+
+func candidates(names []string) (list []string) {
+	for _, n := range names {
+		if n = strings.TrimSpace(n); n != "" {
+			list = append(list, n)
+		}
+	}
+	return
+}
+
+// restrict checks v against the Only candidates. A candidate that is a
+// superset of v replaces it, so gb2312 is answered as gb18030 when only
+// gb18030 is allowed.
+func (cfg *config) restrict(v string) (string, bool) {
+	if cfg.only == nil {
+		return v, true
+	}
+	c := candidate(v, cfg.only)
+	switch {
+	case c == "":
+		return "", false
+	case Canonical(c) == Canonical(v) || sameEncoding(c, v):
+		return v, true
+	default:
+		return c, true
+	}
+}
+
+// candidate returns the name in list that means the same encoding as v, or
+// one of its supersets.
+func candidate(v string, list []string) string {
+	family := Supersets(v)
+	for _, c := range list {
+		if slices.Contains(family, Canonical(c)) || sameEncoding(c, v) {
+			return c
+		}
+	}
+	return ""
+}
+
+func fittingCandidate(dat []byte, list []string) (string, error) {
+	for _, c := range list {
+		if enc, ok := encodingByName(c); ok && decodesCleanly(enc, dat) {
+			return c, nil
+		}
+	}
+	return "", fmt.Errorf("none of %s fits", strings.Join(list, ","))
+}
+
+func preferCandidate(dat []byte, v string, list []string) string {
+	if candidate(v, list) != "" {
+		return v
+	}
+	if c, err := fittingCandidate(dat, list); err == nil {
+		return c
+	}
+	return v
+}
+
+// limitResults returns the first of the ranked names that is a candidate,
+// for backends that report all encodings they consider.
+func limitResults(ranked []string, list []string, backend string) (string, error) {
+	for _, v := range ranked {
+		if candidate(v, list) != "" {
+			return v, nil
+		}
+	}
+	return "", errors.New("no candidate detected by " + backend)
+}
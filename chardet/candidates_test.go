@@ -0,0 +1,30 @@
+package chardet
+
+import (
+	"testing"
+)
+
+func TestCandidates(t *testing.T) {
+	const gbk = "\xd6\xd0\xce\xc4\xb2\xe2\xca\xd4" // 中文测试
+	var cases = []struct {
+		dat  string
+		opt  Option
+		want string
+	}{
+		{gbk, Prefer("gb18030", "utf-8"), "gb18030"},
+		{gbk, Only("utf-8", "gb18030"), "gb18030"},
+		{"plain ascii text", Only("utf-8", "gb18030"), "utf-8"},
+		{"中文测试", Prefer("gb18030", "utf-8"), "utf-8"},
+		{"\xef\xbb\xbf中文测试", Only("utf-8"), UTF8WithBOM},
+		{"caf\xe9 cr\xe8me br\xfbl\xe9e", Prefer("gb18030"), "windows-1252"},
+	}
+	for _, c := range cases {
+		got, err := DetectEncoding([]byte(c.dat), c.opt)
+		if err != nil || !sameEncoding(got, c.want) {
+			t.Errorf("%q: got %q (%v), want %q", c.dat, got, err, c.want)
+		}
+	}
+	if got, err := DetectEncoding([]byte("caf\xe9 cr\xe8me"), Only("utf-8")); err == nil {
+		t.Errorf("latin-1 text limited to utf-8: got %q", got)
+	}
+}
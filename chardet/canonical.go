@@ -0,0 +1,129 @@
+package chardet
+
+import (
+	"strings"
+
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+)
+
+// canonicalNames maps labels that htmlindex folds into a superset, or that
+// only some backend uses, to their canonical name. Everything else takes the
+// WHATWG name from htmlindex, then the IANA name from ianaindex.
+var canonicalNames = map[string]string{
+	"ascii":             "ascii",
+	"us-ascii":          "ascii",
+	"ansi_x3.4-1968":    "ascii",
+	"iso646-us":         "ascii",
+	"iso-8859-1":        "iso-8859-1",
+	"iso8859-1":         "iso-8859-1",
+	"iso_8859-1":        "iso-8859-1",
+	"iso_8859-1:1987":   "iso-8859-1",
+	"latin1":            "iso-8859-1",
+	"latin-1":           "iso-8859-1",
+	"l1":                "iso-8859-1",
+	"cp819":             "iso-8859-1",
+	"ibm819":            "iso-8859-1",
+	"iso-8859-9":        "iso-8859-9",
+	"iso8859-9":         "iso-8859-9",
+	"iso_8859-9":        "iso-8859-9",
+	"iso_8859-9:1989":   "iso-8859-9",
+	"latin5":            "iso-8859-9",
+	"l5":                "iso-8859-9",
+	"iso-8859-11":       "iso-8859-11",
+	"iso8859-11":        "iso-8859-11",
+	"tis-620":           "tis-620",
+	"tis620":            "tis-620",
+	"gb2312":            "gb2312",
+	"gb-2312":           "gb2312",
+	"gb_2312-80":        "gb2312",
+	"euc-cn":            "gb2312",
+	"csgb2312":          "gb2312",
+	"gb-18030":          "gb18030",
+	"big5-hkscs":        "big5-hkscs",
+	"big5hkscs":         "big5-hkscs",
+	"maccyrillic":       "x-mac-cyrillic",
+	"mac-cyrillic":      "x-mac-cyrillic",
+	"macroman":          "macintosh",
+	"mac-roman":         "macintosh",
+	"cp949":             "euc-kr",
+	"hz":                "hz-gb-2312",
+	"hz-gb-2312":        "hz-gb-2312",
+	"iso-2022-cn":       "iso-2022-cn",
+	"iso2022-jp":        "iso-2022-jp",
+	"iso2022-kr":        "iso-2022-kr",
+	"iso-10646-utf-8":   "utf-8",
+	"utf-8-sig":         UTF8WithBOM,
+	"utf8-sig":          UTF8WithBOM,
+	"utf-16":            "utf-16",
+	"ucs-2":             "utf-16",
+	"iso-10646-ucs-2":   "utf-16",
+	"utf-16-le":         "utf-16le",
+	"utf-16-be":         "utf-16be",
+	"utf-32":            "utf-32",
+	"ucs-4":             "utf-32",
+	"iso-10646-ucs-4":   "utf-32",
+	"utf-32-le":         "utf-32le",
+	"utf-32-be":         "utf-32be",
+	"unicode-1-1-utf-7": "utf-7",
+}
+
+// supersets maps an encoding to the smallest encoding that decodes all of its
+// text the same way.
+var supersets = map[string]string{
+	"ascii":       "utf-8",
+	"gb2312":      "gbk",
+	"gbk":         "gb18030",
+	"iso-8859-1":  "windows-1252",
+	"iso-8859-9":  "windows-1254",
+	"tis-620":     "iso-8859-11",
+	"iso-8859-11": "windows-874",
+	"big5":        "big5-hkscs",
+}
+
+// Canonical maps an encoding name as returned by any backend, like GB-18030,
+// WINDOWS-1252, shift-jis or an RFC 1345 name, to one canonical name. Unknown
+// names are returned in lowercase.
+func Canonical(name string) string {
+	key := strings.ToLower(strings.TrimSpace(name))
+	for _, k := range []string{key, strings.ReplaceAll(key, "_", "-")} {
+		if v, ok := canonicalNames[k]; ok {
+			return v
+		}
+		if enc, err := htmlindex.Get(k); err == nil {
+			if v, err := htmlindex.Name(enc); err == nil && v != "replacement" {
+				return v
+			}
+		}
+		if enc, err := ianaindex.IANA.Encoding(k); err == nil && enc != nil {
+			if v, err := ianaindex.IANA.Name(enc); err == nil {
+				return strings.ToLower(v)
+			}
+		}
+	}
+	return key
+}
+
+// Supersets returns the canonical name of an encoding followed by the
+// encodings that extend it, narrowest first, like gb2312, gbk, gb18030.
+func Supersets(name string) []string {
+	family := []string{Canonical(name)}
+	for v, ok := supersets[family[0]]; ok; v, ok = supersets[v] {
+		family = append(family, v)
+	}
+	return family
+}
+
+// Widest returns the widest safe superset of an encoding.
+func Widest(name string) string {
+	family := Supersets(name)
+	return family[len(family)-1]
+}
+
+// UpgradeToSuperset makes DetectEncoding answer the widest safe superset of
+// the detected encoding, gb18030 for gb2312 or windows-1252 for iso-8859-1.
+func UpgradeToSuperset() Option {
+	return func(c *config) {
+		c.widest = true
+	}
+}
@@ -0,0 +1,61 @@
+package chardet
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCanonical(t *testing.T) {
+	var cases = []struct {
+		name, want string
+	}{
+		{"GB-18030", "gb18030"},          // gogs
+		{"GB2312", "gb2312"},             // wlynxg
+		{"WINDOWS-1252", "windows-1252"}, // uchardet
+		{"ISO-10646-UTF-8", "utf-8"},     // enca
+		{"CP1251", "windows-1251"},       // enca, charamel
+		{"shift-jis", "shift_jis"},       // charamel
+		{"utf_16_be", "utf-16be"},        // charamel wasm
+		{"latin_1", "iso-8859-1"},        // charamel wasm
+		{"MacCyrillic", "x-mac-cyrillic"},
+		{"Ascii", "ascii"},
+		{"UTF-8-SIG", UTF8WithBOM},
+		{UTF16LEWithBOM, UTF16LEWithBOM},
+		{"cp866", "ibm866"},
+		{"IBM037", "ibm037"},
+		{"HZ-GB-2312", "hz-gb-2312"},
+		{"EUC-TW", "euc-tw"},
+	}
+	for _, c := range cases {
+		if got := Canonical(c.name); got != c.want {
+			t.Errorf("Canonical(%q) = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestSupersets(t *testing.T) {
+	var cases = []struct {
+		name string
+		want []string
+	}{
+		{"GB2312", []string{"gb2312", "gbk", "gb18030"}},
+		{"gbk", []string{"gbk", "gb18030"}},
+		{"Ascii", []string{"ascii", "utf-8"}},
+		{"latin1", []string{"iso-8859-1", "windows-1252"}},
+		{"Big5", []string{"big5", "big5-hkscs"}},
+		{"koi8-r", []string{"koi8-r"}},
+	}
+	for _, c := range cases {
+		if got := Supersets(c.name); !slices.Equal(got, c.want) {
+			t.Errorf("Supersets(%q) = %q, want %q", c.name, got, c.want)
+		}
+	}
+	got, err := DetectEncoding([]byte("plain ascii text"), UpgradeToSuperset())
+	if err != nil || got != "utf-8" {
+		t.Errorf("ascii upgraded: got %q (%v)", got, err)
+	}
+	got, err = DetectEncoding([]byte("\xd6\xd0\xce\xc4\xb2\xe2\xca\xd4"), Only("gb18030"))
+	if err != nil || got != "gb18030" {
+		t.Errorf("gbk text limited to gb18030: got %q (%v)", got, err)
+	}
+}
@@ -1,3 +1,5 @@
+import codecs
+import os
 import sys
 
 from charamel import Detector, Encoding
@@ -58,7 +60,22 @@ ENCODINGS = (
 )
 
 
+def codec(name):
+    try:
+        return codecs.lookup(name).name
+    except LookupError:
+        return None
+
+
+wanted = {codec(n) for n in os.environ.get("CHARAMEL_CANDIDATES", "").split(",") if n}
+wanted.discard(None)
+encodings = ENCODINGS
+if wanted:
+    encodings = tuple(e for e in ENCODINGS if codec(e.value) in wanted)
+
 data = sys.stdin.buffer.read()
-detector = Detector(ENCODINGS)
+if not encodings:
+    sys.exit(0)
+detector = Detector(encodings)
 encoding = detector.detect(data)
 sys.stdout.write(encoding.value if encoding is not None else "")
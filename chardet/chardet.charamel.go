@@ -9,7 +9,7 @@ import (
 )
 
 func init() {
-	prefer(DetectEncodingByCharamel)
+	preferLimited("charamel", DetectEncodingByCharamel, DetectEncodingByCharamelOnly)
 }
 
 var encodings = []charamel.Encoding{
@@ -86,7 +86,24 @@ var encodings = []charamel.Encoding{
 }
 
 func DetectEncodingByCharamel(dat []byte) (string, error) {
-	d, err := charamel.NewDetector(encodings, 0)
+	return detectByCharamel(dat, encodings)
+}
+
+func DetectEncodingByCharamelOnly(dat []byte, candidates []string) (string, error) {
+	var limited []charamel.Encoding
+	for _, e := range encodings {
+		if candidate(mapName(&e), candidates) != "" {
+			limited = append(limited, e)
+		}
+	}
+	if len(limited) == 0 {
+		return "", errors.New("no candidate supported by github.com/gonejack/charamel")
+	}
+	return detectByCharamel(dat, limited)
+}
+
+func detectByCharamel(dat []byte, list []charamel.Encoding) (string, error) {
+	d, err := charamel.NewDetector(list, 0)
 	if err != nil {
 		return "", err
 	}
@@ -24,6 +24,7 @@ const (
 	charamelPythonWasmEnv = "CHARDET_CHARAMEL_PYTHON_WASM"
 	charamelWasiRootEnv   = "CHARDET_CHARAMEL_WASI_ROOT"
 	charamelWasiAppEnv    = "CHARDET_CHARAMEL_WASI_APP"
+	charamelCandidatesEnv = "CHARAMEL_CANDIDATES"
 )
 
 //go:embed charamel_wasi/python.wasm
@@ -57,7 +58,22 @@ type charamelRuntimeState struct {
 
 var charamelWasmRuntime charamelRuntimeState
 
+func init() {
+	preferLimited("charamel-wasm", DetectEncodingByCharamelWasm, DetectEncodingByCharamelWasmOnly)
+}
+
 func DetectEncodingByCharamelWasm(dat []byte) (string, error) {
+	return detectByCharamelWasm(dat, nil)
+}
+
+// DetectEncodingByCharamelWasmOnly is DetectEncodingByCharamelWasm limited to
+// candidates, detect.py matches them to charamel encodings through Python's
+// codec aliases.
+func DetectEncodingByCharamelWasmOnly(dat []byte, candidates []string) (string, error) {
+	return detectByCharamelWasm(dat, candidates)
+}
+
+func detectByCharamelWasm(dat []byte, candidates []string) (string, error) {
 	if len(dat) == 0 {
 		return "", errors.New("charamel wasm: empty input")
 	}
@@ -77,6 +93,7 @@ func DetectEncodingByCharamelWasm(dat []byte) (string, error) {
 			WithEnv("PYTHONPATH", "/app").
 			WithEnv("PYTHONHOME", charamelWasmRuntime.homeDir).
 			WithEnv("PYTHONDONTWRITEBYTECODE", "1").
+			WithEnv(charamelCandidatesEnv, strings.Join(candidates, ",")).
 			WithStdin(bytes.NewReader(dat)).
 			WithStdout(&stdout).
 			WithStderr(&stderr).
@@ -197,47 +214,10 @@ func charamelWasiRuntime() (charamelWasiSource, error) {
 }
 
 func normalizeCharamelWasmEncoding(v string) string {
-	v = strings.TrimSpace(strings.ToLower(v))
-	switch v {
-	case "utf_8":
-		return "utf-8"
-	case "utf_8_sig":
-		return "utf-8-bom"
-	case "utf_16":
-		return "utf-16"
-	case "utf_16_be":
-		return "utf-16be"
-	case "utf_16_le":
-		return "utf-16le"
-	case "utf_32":
-		return "utf-32"
-	case "utf_32_be":
-		return "utf-32be"
-	case "utf_32_le":
-		return "utf-32le"
-	case "big5hkscs":
-		return "big5-hkscs"
-	case "euc_jp":
-		return "euc-jp"
-	case "euc_kr":
-		return "euc-kr"
-	case "shift_jis":
-		return "shift-jis"
-	case "iso2022_jp":
-		return "iso-2022-jp"
-	case "iso2022_kr":
-		return "iso-2022-kr"
-	case "koi8_r":
-		return "koi8-r"
-	case "koi8_u":
-		return "koi8-u"
-	case "tis_620":
-		return "tis-620"
-	case "latin_1":
-		return "iso-8859-1"
-	default:
-		return v
+	if v = strings.TrimSpace(v); v == "" {
+		return ""
 	}
+	return Canonical(v)
 }
 
 func formatCharamelWasmError(stage string, err error, stderr string) error {
@@ -7,7 +7,7 @@ import (
 )
 
 func init() {
-	prefer(DetectEncodingByEnca)
+	prefer("enca", DetectEncodingByEnca)
 }
 
 func DetectEncodingByEnca(dat []byte) (string, error) {
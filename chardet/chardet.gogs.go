@@ -13,3 +13,15 @@ func DetectEncodingByGogsChardet(dat []byte) (string, error) {
 	}
 	return v.Charset, nil
 }
+
+func DetectEncodingByGogsChardetOnly(dat []byte, candidates []string) (string, error) {
+	all, err := chardet.NewTextDetector().DetectAll(dat)
+	if err != nil {
+		return "", fmt.Errorf("detect failed by github.com/gogs/chardet: %w", err)
+	}
+	ranked := make([]string, len(all))
+	for i, v := range all {
+		ranked[i] = v.Charset
+	}
+	return limitResults(ranked, candidates, "github.com/gogs/chardet")
+}
@@ -0,0 +1,86 @@
+package chardet
+
+import (
+	"encoding/binary"
+	"errors"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// DetectEncodingByNulPattern recognizes BOM-less UTF-16 and UTF-32 by where
+// NUL bytes fall and whether the code units decode to valid text. Trailing
+// bytes of an incomplete unit are ignored, as dat is usually a prefix.
+func DetectEncodingByNulPattern(dat []byte) (string, error) {
+	switch {
+	case validUTF32(dat, binary.LittleEndian):
+		return "utf-32le", nil
+	case validUTF32(dat, binary.BigEndian):
+		return "utf-32be", nil
+	}
+	if len(dat) >= 4 {
+		even, odd := nulCount(dat)
+		n := len(dat) / 2
+		switch {
+		// ASCII in text puts a NUL in the high byte of many units, while a
+		// NUL low byte only comes from the rarer U+xx00 characters.
+		case odd*10 >= n && even*4 <= odd && validUTF16(dat, binary.LittleEndian):
+			return "utf-16le", nil
+		case even*10 >= n && odd*4 <= even && validUTF16(dat, binary.BigEndian):
+			return "utf-16be", nil
+		}
+	}
+	return "", errors.New("detect failed by NUL pattern")
+}
+
+func nulCount(dat []byte) (even, odd int) {
+	for i := 0; i+1 < len(dat); i += 2 {
+		if dat[i] == 0 {
+			even++
+		}
+		if dat[i+1] == 0 {
+			odd++
+		}
+	}
+	return
+}
+
+func validUTF32(dat []byte, order binary.ByteOrder) bool {
+	n := len(dat) / 4
+	if n < 2 {
+		return false
+	}
+	nul := 0
+	for i := 0; i < n; i++ {
+		r := rune(order.Uint32(dat[i*4:]))
+		switch {
+		case r == 0:
+			nul++
+		case !utf8.ValidRune(r):
+			return false
+		}
+	}
+	return nul*10 < n
+}
+
+func validUTF16(dat []byte, order binary.ByteOrder) bool {
+	n := len(dat) / 2
+	nul := 0
+	for i := 0; i < n; i++ {
+		u := rune(order.Uint16(dat[i*2:]))
+		switch {
+		case u == 0:
+			nul++
+		case u >= 0xDC00 && u <= 0xDFFF:
+			return false
+		case utf16.IsSurrogate(u):
+			if i+1 == n {
+				break // cut off after the high surrogate
+			}
+			i++
+			if l := rune(order.Uint16(dat[i*2:])); l < 0xDC00 || l > 0xDFFF {
+				return false
+			}
+		}
+	}
+	return nul*10 < n
+}
@@ -0,0 +1,22 @@
+//go:build unix
+
+package chardet
+
+import (
+	"bytes"
+	"testing"
+)
+
+// FuzzCstrToSlice checks the walk over C strings stops at the first NUL.
+func FuzzCstrToSlice(f *testing.F) {
+	f.Add([]byte("UTF-8"))
+	f.Add([]byte(""))
+	f.Add([]byte("GB18030\x00WINDOWS-1252"))
+	f.Fuzz(func(t *testing.T, s []byte) {
+		buf := append(bytes.Clone(s), 0)
+		want, _, _ := bytes.Cut(s, []byte{0})
+		if got := cstrToSlice(&buf[0]); !bytes.Equal(got, want) {
+			t.Errorf("cstrToSlice(%q) = %q, want %q", buf, got, want)
+		}
+	})
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func init() {
-	prefer(DetectEncodingByUChardet)
+	prefer("uchardet", DetectEncodingByUChardet)
 }
 
 func DetectEncodingByUChardet(dat []byte) (string, error) {
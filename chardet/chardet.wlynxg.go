@@ -6,10 +6,20 @@ import (
 	"github.com/wlynxg/chardet"
 )
 
-func DetectEncodingByWlynxgChardet(dat []byte) (string, error) {
+func DetectEncodingByWlynxgChardet(dat []byte) (_ string, err error) {
+	defer recoverBackend(&err, "github.com/wlynxg/chardet")
 	v := chardet.Detect(dat)
 	if v.Encoding > "" {
 		return v.Encoding, nil
 	}
 	return "", errors.New("detect failed by github.com/wlynxg/chardet")
 }
+
+func DetectEncodingByWlynxgChardetOnly(dat []byte, candidates []string) (_ string, err error) {
+	defer recoverBackend(&err, "github.com/wlynxg/chardet")
+	var ranked []string
+	for _, v := range chardet.DetectAll(dat) {
+		ranked = append(ranked, v.Encoding)
+	}
+	return limitResults(ranked, candidates, "github.com/wlynxg/chardet")
+}
@@ -0,0 +1,140 @@
+package chardet
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// Declaration is an in-band charset declaration, Start and End are the byte
+// offsets of Charset in the sniffed data.
+type Declaration struct {
+	Kind    string
+	Charset string
+	Start   int
+	End     int
+}
+
+// Trust is the policy for in-band charset declarations.
+type Trust string
+
+const (
+	TrustAlways Trust = "always" // use the declaration if the charset is known
+	TrustVerify Trust = "verify" // use it only if the data decodes cleanly too
+	TrustNever  Trust = "never"  // ignore declarations
+)
+
+var declarations = []struct {
+	kind  string
+	lines int // only look at the first lines, 0 for anywhere
+	re    *regexp.Regexp
+}{
+	{"css", 1, regexp.MustCompile(`\A@charset "([-\w.:]+)";`)},
+	{"xml", 0, regexp.MustCompile(`\A\s*<\?xml\s[^>]*?\bencoding\s*=\s*["']([-\w.:]+)["']`)},
+	{"coding", 2, regexp.MustCompile(`(?m)^[ \t\f]*#.*?coding[:=][ \t]*([-\w.]+)`)},
+	{"coding", 2, regexp.MustCompile(`-\*-.*?\bcoding:[ \t]*([-\w.]+)`)},
+	{"vim", 5, regexp.MustCompile(`(?:^|\s)(?:vi|vim|ex):.*?\b(?:fenc|fileencoding)=([-\w.]+)`)},
+	{"html", 0, regexp.MustCompile(`(?i)<meta\s[^>]*?\bcharset\s*=\s*["']?([-\w.:]+)`)},
+}
+
+// SniffDeclaration finds the charset declared by an XML prolog, HTML meta
+// tag, CSS @charset rule, Python or Emacs coding cookie, or Vim modeline.
+func SniffDeclaration(dat []byte) (Declaration, bool) {
+	for _, d := range declarations {
+		src := dat
+		if d.lines > 0 {
+			src = firstLines(dat, d.lines)
+		}
+		if m := d.re.FindSubmatchIndex(src); m != nil {
+			return Declaration{Kind: d.kind, Charset: string(dat[m[2]:m[3]]), Start: m[2], End: m[3]}, true
+		}
+	}
+	return Declaration{}, false
+}
+
+func firstLines(dat []byte, n int) []byte {
+	end := 0
+	for ; n > 0; n-- {
+		i := bytes.IndexByte(dat[end:], '\n')
+		if i < 0 {
+			return dat
+		}
+		end += i + 1
+	}
+	return dat[:end]
+}
+
+// declaredName normalizes charset names written for Emacs and Python, like
+// utf-8-unix or euc_jp.
+func declaredName(charset string) string {
+	v := strings.ToLower(charset)
+	for _, eol := range []string{"-unix", "-dos", "-mac"} {
+		v = strings.TrimSuffix(v, eol)
+	}
+	if _, err := htmlindex.Get(v); err != nil {
+		v = strings.ReplaceAll(v, "_", "-")
+	}
+	return v
+}
+
+// DetectEncodingByDeclaration returns the declared charset of dat if it is
+// known. With TrustVerify it must also decode dat without errors, and a
+// single-byte charset is not taken for data that is valid UTF-8, which every
+// single-byte charset decodes but hardly ever is meant for.
+func DetectEncodingByDeclaration(dat []byte, trust Trust) (string, bool) {
+	if trust == TrustNever {
+		return "", false
+	}
+	d, ok := SniffDeclaration(dat)
+	if !ok {
+		return "", false
+	}
+	name := declaredName(d.Charset)
+	// the declaration was readable as ASCII, so the data cannot be UTF-16/32
+	if strings.HasPrefix(name, "utf-16") || strings.HasPrefix(name, "utf-32") {
+		return "", false
+	}
+	enc, ok := encodingByName(name)
+	switch {
+	case !ok:
+		return "", false
+	case trust == TrustAlways:
+		return name, true
+	case !decodesCleanly(enc, dat):
+		return "", false
+	}
+	if _, single := enc.(*charmap.Charmap); single && isUTF8Text(dat) {
+		return "", false
+	}
+	return name, true
+}
+
+// isUTF8Text reports whether dat is valid UTF-8 with non-ASCII characters, dat
+// may end in the middle of a character.
+func isUTF8Text(dat []byte) bool {
+	for i := len(dat) - 1; i >= 0 && i >= len(dat)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(dat[i]) {
+			if !utf8.FullRune(dat[i:]) {
+				dat = dat[:i]
+			}
+			break
+		}
+	}
+	return utf8.Valid(dat) && bytes.IndexFunc(dat, func(r rune) bool { return r >= utf8.RuneSelf }) >= 0
+}
+
+func decodesCleanly(enc encoding.Encoding, dat []byte) bool {
+	return !bytes.ContainsRune(decodePrefix(enc, dat), utf8.RuneError)
+}
+
+// decodePrefix decodes dat, which may end in the middle of a character.
+func decodePrefix(enc encoding.Encoding, dat []byte) []byte {
+	dst := make([]byte, 3*len(dat)+utf8.UTFMax)
+	n, _, _ := enc.NewDecoder().Transform(dst, dat, false)
+	return dst[:n]
+}
@@ -0,0 +1,74 @@
+package chardet
+
+import (
+	"testing"
+)
+
+func TestSniffDeclaration(t *testing.T) {
+	var cases = []struct {
+		dat     string
+		kind    string
+		charset string
+	}{
+		{`<?xml version="1.0" encoding="GBK"?><a/>`, "xml", "GBK"},
+		{"\n <?xml version='1.0' encoding='Shift_JIS' standalone='yes'?>", "xml", "Shift_JIS"},
+		{`<html><head><meta charset="gb2312"></head>`, "html", "gb2312"},
+		{`<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=big5">`, "html", "big5"},
+		{`<meta charset=koi8-r>`, "html", "koi8-r"},
+		{`@charset "windows-1251";` + "\nbody {}", "css", "windows-1251"},
+		{"#!/usr/bin/env python\n# -*- coding: gbk -*-\n", "coding", "gbk"},
+		{"# vim: set fileencoding=euc_jp :\n", "coding", "euc_jp"},
+		{"/* -*- mode: c; coding: utf-8-unix -*- */\n", "coding", "utf-8-unix"},
+		{"// vim: set fenc=cp936 ff=unix:\nint x;\n", "vim", "cp936"},
+		{"plain text with charset=gbk in it", "", ""},
+		{"line1\nline2\n# coding: gbk\n", "", ""},
+		{`@charset 'gbk';`, "", ""},
+	}
+	for _, c := range cases {
+		d, ok := SniffDeclaration([]byte(c.dat))
+		if ok != (c.kind != "") || d.Kind != c.kind || d.Charset != c.charset {
+			t.Errorf("SniffDeclaration(%q) = %+v, %v, want %s %s", c.dat, d, ok, c.kind, c.charset)
+			continue
+		}
+		if ok && c.dat[d.Start:d.End] != c.charset {
+			t.Errorf("%q: offsets %d-%d point at %q", c.dat, d.Start, d.End, c.dat[d.Start:d.End])
+		}
+	}
+}
+
+func TestDetectEncodingByDeclaration(t *testing.T) {
+	const gbk = "<meta charset=\"gbk\"><p>\xd6\xd0\xce\xc4</p>"
+	const utf8 = "<meta charset=\"utf-8\"><p>\xd6\xd0\xce\xc4</p>"
+	var cases = []struct {
+		dat   string
+		trust Trust
+		want  string
+	}{
+		{gbk, TrustAlways, "gbk"},
+		{gbk, TrustVerify, "gbk"},
+		{gbk, TrustNever, ""},
+		{utf8, TrustAlways, "utf-8"},
+		{utf8, TrustVerify, ""},
+		{"# coding: euc_jp\n", TrustVerify, "euc-jp"},
+		{"# coding: no-such-charset\n", TrustAlways, ""},
+		{"# coding: no-such-charset\n", TrustVerify, ""},
+		{`<meta charset="utf-16">`, TrustAlways, ""},
+		{"<meta charset=iso-8859-1><p>Caf\xc3\xa9 cr\xc3\xa8me</p>", TrustAlways, "iso-8859-1"},
+		{"<meta charset=iso-8859-1><p>Caf\xc3\xa9 cr\xc3\xa8me</p>", TrustVerify, ""},
+		{"<meta charset=iso-8859-1><p>Caf\xe9 cr\xe8me</p>", TrustVerify, "iso-8859-1"},
+		{"<meta charset=iso-8859-1><p>Cafe</p>", TrustVerify, "iso-8859-1"},
+		{"<meta charset=iso-8859-1><p>Caf\xc3\xa9 \xe3\x83", TrustVerify, ""},
+	}
+	for _, c := range cases {
+		got, ok := DetectEncodingByDeclaration([]byte(c.dat), c.trust)
+		if got != c.want || ok != (c.want != "") {
+			t.Errorf("%q with %s: got %q, %v, want %q", c.dat, c.trust, got, ok, c.want)
+		}
+	}
+	if got, err := DetectEncoding([]byte(gbk), TrustDeclared(TrustNever)); err == nil && got == "gbk" {
+		t.Errorf("TrustNever still used the declaration")
+	}
+	if got, err := DetectEncoding([]byte("<meta charset=no-such-charset><p>\xd6\xd0\xce\xc4</p>"), TrustDeclared(TrustAlways)); err != nil || got == "no-such-charset" {
+		t.Errorf("TrustAlways with an unknown charset = %q, %v, want detection", got, err)
+	}
+}
@@ -0,0 +1,96 @@
+package chardet
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fuzzTimeout bounds a single detection, backends run interpreters and C
+// code that must not loop on any input.
+const fuzzTimeout = 10 * time.Second
+
+// addCorpus seeds f with the detection corpus cut to the 2048 bytes detection
+// looks at.
+func addCorpus(f *testing.F) {
+	files, err := filepath.Glob(filepath.Join(corpus, "*", "*.txt"))
+	if err != nil || len(files) == 0 {
+		f.Fatalf("no corpus files: %v", err)
+	}
+	for _, file := range files {
+		dat, err := os.ReadFile(file)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(dat[:min(len(dat), 2048)])
+	}
+	f.Add([]byte{})
+	f.Add([]byte{0xff, 0xfe, 0x00})
+	f.Add([]byte{0xe9}) // wlynxg panicked on it
+}
+
+// within runs detect and fails the test if it does not return in time.
+func within(t *testing.T, name string, detect func([]byte) (string, error), dat []byte) (v string, err error) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		v, err = detect(dat)
+	}()
+	select {
+	case <-done:
+		return
+	case <-time.After(fuzzTimeout):
+		t.Fatalf("%s did not return within %s on %q", name, fuzzTimeout, dat)
+		return
+	}
+}
+
+func FuzzDetectEncoding(f *testing.F) {
+	addCorpus(f)
+	f.Fuzz(func(t *testing.T, dat []byte) {
+		v, err := within(t, "DetectEncoding", func(dat []byte) (string, error) { return DetectEncoding(dat) }, dat)
+		if err != nil {
+			return
+		}
+		if v == "" || Canonical(v) != v {
+			t.Errorf("DetectEncoding(%q) = %q, not a canonical name", dat, v)
+		}
+		r, err := Detect(dat)
+		if err != nil || r.Encoding != v {
+			t.Errorf("Detect(%q) = %q (%v), DetectEncoding answered %q", dat, r.Encoding, err, v)
+		}
+	})
+}
+
+// FuzzBackends runs every backend compiled in except external commands.
+func FuzzBackends(f *testing.F) {
+	addCorpus(f)
+	backends := slices.DeleteFunc(Backends(), func(b Backend) bool { return b.Name == "uchardet-cmd" })
+	if lib != 0 {
+		backends = append(backends, Backend{"uchardet-dylib", DetectEncodingByUChardetDylib})
+	}
+	f.Fuzz(func(t *testing.T, dat []byte) {
+		for _, b := range backends {
+			if v, err := within(t, b.Name, b.Detect, dat); err == nil && v == "" {
+				t.Errorf("%s(%q) answered an empty name", b.Name, dat)
+			}
+		}
+	})
+}
+
+func FuzzSniff(f *testing.F) {
+	addCorpus(f)
+	f.Add([]byte(`<?xml version="1.0" encoding="gbk"?>`))
+	f.Add([]byte("# -*- coding: latin-1 -*-\n"))
+	f.Fuzz(func(t *testing.T, dat []byte) {
+		if name, n := SniffBOM(dat); n < 0 || n > len(dat) || (n > 0) != (name != "") {
+			t.Errorf("SniffBOM(%q) = %q, %d", dat, name, n)
+		}
+		if d, ok := SniffDeclaration(dat); ok && (d.Start >= d.End || d.End > len(dat) || string(dat[d.Start:d.End]) != d.Charset) {
+			t.Errorf("SniffDeclaration(%q) = %+v", dat, d)
+		}
+		within(t, "DetectEncodingByNulPattern", DetectEncodingByNulPattern, dat)
+	})
+}
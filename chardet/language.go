@@ -0,0 +1,323 @@
+package chardet
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/wlynxg/chardet"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/unicode/utf32"
+)
+
+// Result is a detected encoding with the language and script of the text,
+// Language is an ISO 639-1 code and Script an ISO 15924 code, both empty
+// when unknown.
+type Result struct {
+	Encoding string `json:"encoding"`
+	Language string `json:"language,omitempty"`
+	Script   string `json:"script,omitempty"`
+}
+
+type language struct {
+	code      string
+	name      string // as reported by github.com/wlynxg/chardet
+	script    string
+	encodings []string
+	alphabet  string // lowercase letters beyond ASCII, empty to accept the script
+}
+
+// languages lists the legacy encodings plausible for each language, the
+// first one is the most common.
+var languages = []language{
+	{"en", "English", "Latn", []string{"windows-1252", "iso-8859-15", "macintosh", "ibm437", "ibm850"}, ""},
+	{"fr", "French", "Latn", []string{"windows-1252", "iso-8859-15", "macintosh", "ibm850"}, "àâæçéèêëîïôœùûüÿ"},
+	{"de", "German", "Latn", []string{"windows-1252", "iso-8859-15", "macintosh", "ibm850"}, "äöüß"},
+	{"es", "Spanish", "Latn", []string{"windows-1252", "iso-8859-15", "macintosh", "ibm850"}, "áéíñóúü"},
+	{"it", "Italian", "Latn", []string{"windows-1252", "iso-8859-15", "macintosh", "ibm850"}, "àèéìíîòóùú"},
+	{"pt", "Portuguese", "Latn", []string{"windows-1252", "iso-8859-15", "ibm860", "ibm850"}, "áâãàçéêíóôõú"},
+	{"nl", "Dutch", "Latn", []string{"windows-1252", "iso-8859-15", "macintosh", "ibm850"}, "éëïóöü"},
+	{"pl", "Polish", "Latn", []string{"windows-1250", "iso-8859-2", "ibm852"}, "ąćęłńóśźż"},
+	{"cs", "Czech", "Latn", []string{"windows-1250", "iso-8859-2", "ibm852"}, "áčďéěíňóřšťúůýž"},
+	{"sk", "Slovak", "Latn", []string{"windows-1250", "iso-8859-2", "ibm852"}, "áäčďéíĺľňóôŕšťúýž"},
+	{"hu", "Hungarian", "Latn", []string{"windows-1250", "iso-8859-2", "ibm852"}, "áéíóöőúüű"},
+	{"ro", "Romanian", "Latn", []string{"windows-1250", "iso-8859-16", "iso-8859-2"}, "ăâîșțşţ"},
+	{"hr", "Croatian", "Latn", []string{"windows-1250", "iso-8859-2", "ibm852"}, "čćđšž"},
+	{"sl", "Slovene", "Latn", []string{"windows-1250", "iso-8859-2", "ibm852"}, "čšž"},
+	{"tr", "Turkish", "Latn", []string{"windows-1254", "iso-8859-9"}, "çğıöşüi"},
+	{"lt", "Lithuanian", "Latn", []string{"windows-1257", "iso-8859-13", "iso-8859-4"}, "ąčęėįšųūž"},
+	{"lv", "Latvian", "Latn", []string{"windows-1257", "iso-8859-13", "iso-8859-4"}, "āčēģīķļņšūž"},
+	{"et", "Estonian", "Latn", []string{"windows-1257", "iso-8859-13", "iso-8859-15"}, "äõöüšž"},
+	{"vi", "Vietnamese", "Latn", []string{"windows-1258"}, ""},
+	{"ru", "Russian", "Cyrl", []string{"windows-1251", "koi8-r", "ibm866", "iso-8859-5", "x-mac-cyrillic"}, "абвгдеёжзийклмнопрстуфхцчшщъыьэюя"},
+	{"uk", "Ukrainian", "Cyrl", []string{"windows-1251", "koi8-u", "ibm866", "iso-8859-5", "x-mac-cyrillic"}, "абвгґдеєжзиіїйклмнопрстуфхцчшщьюя"},
+	{"be", "Belarusian", "Cyrl", []string{"windows-1251", "koi8-u", "ibm866", "iso-8859-5"}, "абвгдеёжзійклмнопрстуўфхцчшыьэюя"},
+	{"bg", "Bulgarian", "Cyrl", []string{"windows-1251", "iso-8859-5", "ibm866", "x-mac-cyrillic"}, "абвгдежзийклмнопрстуфхцчшщъьюя"},
+	{"sr", "Serbian", "Cyrl", []string{"windows-1251", "iso-8859-5", "ibm855"}, "абвгдђежзијклљмнњопрстћуфхцчџш"},
+	{"mk", "Macedonian", "Cyrl", []string{"windows-1251", "iso-8859-5", "ibm855"}, "абвгдѓежзѕијклљмнњопрстќуфхцчџш"},
+	{"el", "Greek", "Grek", []string{"windows-1253", "iso-8859-7"}, "αβγδεζηθικλμνξοπρστυφχψωάέήίόύώϊϋΐΰς"},
+	{"he", "Hebrew", "Hebr", []string{"windows-1255", "iso-8859-8", "iso-8859-8-i", "ibm862"}, ""},
+	{"ar", "Arabic", "Arab", []string{"windows-1256", "iso-8859-6"}, ""},
+	{"fa", "Persian", "Arab", []string{"windows-1256"}, ""},
+	{"th", "Thai", "Thai", []string{"windows-874"}, ""},
+	{"zh", "Chinese", "Hani", []string{"gb18030", "gbk", "big5", "hz-gb-2312"}, ""},
+	{"ja", "Japanese", "Jpan", []string{"shift_jis", "euc-jp", "iso-2022-jp"}, ""},
+	{"ko", "Korean", "Kore", []string{"euc-kr", "iso-2022-kr"}, ""},
+}
+
+func lookupLanguage(code string) (*language, bool) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	for i, l := range languages {
+		if l.code == code || strings.EqualFold(l.name, code) {
+			return &languages[i], true
+		}
+	}
+	return nil, false
+}
+
+// Languages returns the ISO 639-1 codes accepted by Language.
+func Languages() []string {
+	list := make([]string, len(languages))
+	for i, l := range languages {
+		list[i] = l.code
+	}
+	return list
+}
+
+// Language hints the language of the text, detection then prefers the
+// encodings plausible for it where the bytes are ambiguous, like windows-1251,
+// koi8-r and cp866 for Russian. Codes not in Languages are ignored.
+func Language(code string) Option {
+	return func(c *config) {
+		c.lang, _ = lookupLanguage(code)
+	}
+}
+
+// preferLanguage picks among the encodings of lang and v the one that decodes
+// dat into the most plausible text, ties go to the more common encoding of
+// the language. Unicode encodings carry no language question and are kept.
+func preferLanguage(dat []byte, v string, lang *language) string {
+	if v == "ascii" || strings.HasPrefix(strings.ToLower(v), "utf-") {
+		return v
+	}
+	best, bestScore := v, -1.0
+	for _, name := range append(lang.encodings, v) {
+		enc, ok := encodingByName(name)
+		if !ok {
+			continue
+		}
+		if s := plausibility(decodePrefix(enc, dat), lang); s > bestScore {
+			best, bestScore = name, s
+		}
+	}
+	if sameEncoding(best, v) {
+		return v
+	}
+	return best
+}
+
+// plausibility scores text in lang between 0 and 3. Reading text in a wrong
+// code page of the same script scatters symbols and foreign letters into
+// words and flips case, so it weighs the share of letters from the alphabet
+// twice and adds the share of words cased like "word", "Word" or "WORD".
+// ASCII reads the same in all candidates and only counts for casing.
+func plausibility(text []byte, lang *language) float64 {
+	var native, foreign, words, wellCased int
+	var wordLen int
+	var lowerSeen, broken bool
+	endWord := func() {
+		if wordLen >= 2 {
+			words++
+			if !broken {
+				wellCased++
+			}
+		}
+		wordLen, lowerSeen, broken = 0, false, false
+	}
+	for _, r := range string(text) {
+		if !unicode.IsLetter(r) {
+			endWord()
+			if r >= utf8.RuneSelf && !unicode.IsPunct(r) && !unicode.IsSpace(r) {
+				foreign++
+			}
+			continue
+		}
+		if r >= utf8.RuneSelf {
+			if lang.knows(r) {
+				native++
+			} else {
+				foreign++
+			}
+		}
+		switch {
+		case unicode.IsUpper(r):
+			broken = broken || lowerSeen
+		case unicode.IsLower(r):
+			lowerSeen = true
+		}
+		wordLen++
+	}
+	endWord()
+	var score float64
+	if native+foreign > 0 {
+		score += 2 * float64(native) / float64(native+foreign)
+	}
+	if words > 0 {
+		score += float64(wellCased) / float64(words)
+	}
+	return score
+}
+
+// knows reports whether r is a letter of the language beyond ASCII.
+func (l *language) knows(r rune) bool {
+	if l.alphabet == "" {
+		return writtenIn(r, l.script)
+	}
+	return strings.ContainsRune(l.alphabet, unicode.ToLower(r))
+}
+
+var scriptTables = []struct {
+	code  string
+	table *unicode.RangeTable
+}{
+	{"Latn", unicode.Latin},
+	{"Cyrl", unicode.Cyrillic},
+	{"Grek", unicode.Greek},
+	{"Arab", unicode.Arabic},
+	{"Hebr", unicode.Hebrew},
+	{"Thai", unicode.Thai},
+	{"Hani", unicode.Han},
+	{"Hira", unicode.Hiragana},
+	{"Kana", unicode.Katakana},
+	{"Hang", unicode.Hangul},
+	{"Armn", unicode.Armenian},
+	{"Geor", unicode.Georgian},
+	{"Deva", unicode.Devanagari},
+}
+
+func scriptOf(r rune) string {
+	for _, s := range scriptTables {
+		if unicode.Is(s.table, r) {
+			return s.code
+		}
+	}
+	return ""
+}
+
+func writtenIn(r rune, script string) bool {
+	switch s := scriptOf(r); script {
+	case "Jpan":
+		return s == "Hani" || s == "Hira" || s == "Kana"
+	case "Kore":
+		return s == "Hani" || s == "Hang"
+	default:
+		return s == script
+	}
+}
+
+// scriptLanguages names the language of scripts used by a single one.
+var scriptLanguages = map[string]string{
+	"Grek": "el",
+	"Hebr": "he",
+	"Thai": "th",
+	"Jpan": "ja",
+	"Kore": "ko",
+	"Hani": "zh",
+	"Armn": "hy",
+	"Geor": "ka",
+}
+
+// identify returns the language and script of dat decoded as v. The script
+// is the one most letters are written in, Han mixed with kana or Hangul is
+// Japanese or Korean. Scripts shared by many languages take the language from
+// the hint or from github.com/wlynxg/chardet when it agrees on the encoding.
+func identify(dat []byte, v string, hint *language) (lang, script string) {
+	enc, ok := encodingByName(v)
+	if !ok {
+		return
+	}
+	count := make(map[string]int)
+	for _, r := range string(decodePrefix(enc, dat)) {
+		if unicode.IsLetter(r) {
+			count[scriptOf(r)]++
+		}
+	}
+	delete(count, "")
+	n := 0
+	for s, c := range count {
+		if c > n || c == n && s < script {
+			script, n = s, c
+		}
+	}
+	switch {
+	case script == "":
+		return
+	case count["Hira"]+count["Kana"] > 0 && (script == "Hani" || script == "Hira" || script == "Kana"):
+		script = "Jpan"
+	case count["Hang"] > 0 && (script == "Hani" || script == "Hang"):
+		script = "Kore"
+	}
+	if hint != nil && hint.script == script {
+		return hint.code, script
+	}
+	if l, ok := scriptLanguages[script]; ok {
+		return l, script
+	}
+	r := chardet.Detect(dat)
+	if r.Language == "" || !sameEncoding(r.Encoding, v) {
+		return "", script
+	}
+	for _, l := range languages {
+		if l.name == r.Language && l.script == script {
+			return l.code, script
+		}
+	}
+	return "", script
+}
+
+func sameEncoding(a, b string) bool {
+	x, ok := encodingByName(a)
+	y, exx := encodingByName(b)
+	return ok && exx && x == y
+}
+
+// SameText reports whether the detected encoding is the expected one, or at
+// least decodes dat to the same text, like ascii for English windows-1252.
+// Detectors are scored by it.
+func SameText(expected, detected string, dat []byte) bool {
+	if Canonical(expected) == Canonical(detected) || sameEncoding(expected, detected) {
+		return true
+	}
+	x, ok := encodingByName(expected)
+	y, exx := encodingByName(detected)
+	if !ok || !exx {
+		return false
+	}
+	a, err := x.NewDecoder().Bytes(dat)
+	if err != nil {
+		return false
+	}
+	b, err := y.NewDecoder().Bytes(dat)
+	return err == nil && string(a) == string(b)
+}
+
+// encodingByName resolves the names detectors return, including the BOM
+// variants of this package and UTF-32 which htmlindex does not know.
+func encodingByName(name string) (encoding.Encoding, bool) {
+	name = strings.TrimSuffix(Canonical(name), "-bom")
+	switch name {
+	case "ascii":
+		name = "utf-8"
+	case "utf-32", "utf-32be":
+		return utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM), true
+	case "utf-32le":
+		return utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM), true
+	}
+	enc, err := htmlindex.Get(name)
+	if err != nil {
+		enc, err = ianaindex.IANA.Encoding(name)
+	}
+	return enc, err == nil && enc != nil
+}
@@ -0,0 +1,35 @@
+package chardet
+
+import (
+	"testing"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+func TestLanguage(t *testing.T) {
+	const ru = "Привет! Как дела? Сегодня хорошая погода, и мы идём гулять в парк."
+	var cases = []struct {
+		text, enc, lang string
+		want            Result
+	}{
+		{ru, "windows-1251", "ru", Result{"windows-1251", "ru", "Cyrl"}},
+		{ru, "koi8-r", "ru", Result{"koi8-r", "ru", "Cyrl"}},
+		{ru, "ibm866", "ru", Result{"ibm866", "ru", "Cyrl"}},
+		// taken for x-mac-cyrillic without the hint
+		{"Съешь же ещё этих мягких французских булок", "windows-1251", "ru", Result{"windows-1251", "ru", "Cyrl"}},
+		{"Καλημέρα σας, τι κάνετε σήμερα; Ο καιρός είναι ωραίος.", "iso-8859-7", "", Result{"iso-8859-7", "el", "Grek"}},
+		{"日本語のテキストです。今日はいい天気ですね。", "utf-8", "", Result{"utf-8", "ja", "Jpan"}},
+		{"这是一段中文文本，今天天气很好。", "utf-8", "zh", Result{"utf-8", "zh", "Hani"}},
+	}
+	for _, c := range cases {
+		enc, _ := htmlindex.Get(c.enc)
+		dat, _ := enc.NewEncoder().Bytes([]byte(c.text))
+		got, err := Detect(dat, Language(c.lang))
+		if err == nil && sameEncoding(got.Encoding, c.want.Encoding) {
+			got.Encoding = c.want.Encoding
+		}
+		if err != nil || got != c.want {
+			t.Errorf("%s with hint %q: got %+v (%v), want %+v", c.enc, c.lang, got, err, c.want)
+		}
+	}
+}
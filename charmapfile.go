@@ -0,0 +1,310 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// tableEncoding is a single-byte encoding loaded from a mapping file, either
+// the Unicode consortium .TXT format or an ICU .ucm file.
+type tableEncoding struct {
+	name        string
+	decode      [256]rune
+	encode      map[rune]byte
+	replacement byte
+}
+
+func (t *tableEncoding) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: tableDecoder{t: t}}
+}
+func (t *tableEncoding) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: tableEncoder{t: t}}
+}
+func (t *tableEncoding) String() string {
+	return t.name
+}
+
+type tableDecoder struct {
+	transform.NopResetter
+	t *tableEncoding
+}
+
+func (d tableDecoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for _, c := range src {
+		r := d.t.decode[c]
+		if nDst+utf8.RuneLen(r) > len(dst) {
+			err = transform.ErrShortDst
+			break
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], r)
+		nSrc++
+	}
+	return
+}
+
+type tableEncoder struct {
+	transform.NopResetter
+	t *tableEncoding
+}
+
+func (e tableEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if nDst >= len(dst) {
+			err = transform.ErrShortDst
+			break
+		}
+		r, size := utf8.DecodeRune(src[nSrc:])
+		if r == utf8.RuneError && size == 1 && !atEOF && !utf8.FullRune(src[nSrc:]) {
+			err = transform.ErrShortSrc
+			break
+		}
+		b, ok := e.t.encode[r]
+		if !ok || r == utf8.RuneError && size == 1 {
+			err = repertoireError(e.t.replacement)
+			break
+		}
+		dst[nDst] = b
+		nDst++
+		nSrc += size
+	}
+	return
+}
+
+// repertoireError is returned for runes the table cannot encode, its
+// Replacement method lets encoding.ReplaceUnsupported substitute them.
+type repertoireError byte
+
+func (r repertoireError) Error() string {
+	return "encoding: rune not supported by encoding."
+}
+func (r repertoireError) Replacement() byte {
+	return byte(r)
+}
+
+func loadCharmapFile(path string) (*tableEncoding, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	name := strings.ToLower(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+	t := &tableEncoding{name: name, encode: make(map[rune]byte)}
+	for i := range t.decode {
+		t.decode[i] = utf8.RuneError
+	}
+	if strings.EqualFold(filepath.Ext(path), ".ucm") {
+		err = t.parseUCM(f)
+	} else {
+		err = t.parseTXT(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %s failed: %w", path, err)
+	}
+	if len(t.encode) == 0 {
+		return nil, fmt.Errorf("parse %s failed: no mappings found", path)
+	}
+	if _, ok := t.encode[utf8.RuneError]; !ok {
+		if b, ok := t.encode['\x1a']; ok {
+			t.replacement = b
+		} else if b, ok := t.encode['?']; ok {
+			t.replacement = b
+		}
+	}
+	return t, nil
+}
+
+// parseTXT reads lines of the form "0x41	0x0041	# LATIN CAPITAL LETTER A",
+// bytes without a code point are left undefined.
+func (t *tableEncoding) parseTXT(r io.Reader) error {
+	scan := bufio.NewScanner(r)
+	for n := 1; scan.Scan(); n++ {
+		line, _, _ := strings.Cut(scan.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		b, err := strconv.ParseUint(fields[0], 0, 8)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid byte %s, only single-byte code pages are supported", n, fields[0])
+		}
+		c, err := strconv.ParseUint(fields[1], 0, 32)
+		if err != nil || !utf8.ValidRune(rune(c)) {
+			return fmt.Errorf("line %d: invalid code point %s", n, fields[1])
+		}
+		t.add(byte(b), rune(c), true, true)
+	}
+	return scan.Err()
+}
+
+// parseUCM reads the CHARMAP section of an ICU mapping file, where lines look
+// like "<U0041> \x41 |0". Fallbacks (|1) only encode and reverse fallbacks
+// (|3) only decode.
+func (t *tableEncoding) parseUCM(r io.Reader) error {
+	scan := bufio.NewScanner(r)
+	inMap := false
+	for n := 1; scan.Scan(); n++ {
+		line, _, _ := strings.Cut(scan.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch {
+		case fields[0] == "CHARMAP":
+			inMap = true
+			continue
+		case fields[0] == "END":
+			inMap = false
+			continue
+		case !inMap && len(fields) >= 2:
+			switch fields[0] {
+			case "<code_set_name>":
+				t.name = strings.ToLower(strings.Trim(fields[1], `"`))
+			case "<mb_cur_max>":
+				if fields[1] != "1" {
+					return fmt.Errorf("line %d: mb_cur_max %s, only single-byte code pages are supported", n, fields[1])
+				}
+			case "<subchar>":
+				b, err := parseUCMBytes(fields[1])
+				if err != nil || len(b) != 1 {
+					return fmt.Errorf("line %d: invalid subchar %s", n, fields[1])
+				}
+				t.replacement = b[0]
+			}
+			continue
+		case !inMap:
+			continue
+		}
+		if len(fields) < 2 {
+			return fmt.Errorf("line %d: invalid mapping %q", n, line)
+		}
+		c, err := parseUCMCodePoint(fields[0])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", n, err)
+		}
+		b, err := parseUCMBytes(fields[1])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", n, err)
+		}
+		if len(b) != 1 {
+			return fmt.Errorf("line %d: invalid byte sequence %s, only single-byte code pages are supported", n, fields[1])
+		}
+		flag := "|0"
+		if len(fields) > 2 {
+			flag = fields[2]
+		}
+		switch flag {
+		case "|0":
+			t.add(b[0], c, true, true)
+		case "|1":
+			t.add(b[0], c, false, true)
+		case "|3":
+			t.add(b[0], c, true, false)
+		}
+	}
+	return scan.Err()
+}
+
+func (t *tableEncoding) add(b byte, c rune, decode, encode bool) {
+	if decode && t.decode[b] == utf8.RuneError {
+		t.decode[b] = c
+	}
+	if _, ok := t.encode[c]; encode && !ok {
+		t.encode[c] = b
+	}
+}
+
+func parseUCMCodePoint(s string) (rune, error) {
+	if !strings.HasPrefix(s, "<U") || !strings.HasSuffix(s, ">") || strings.Count(s, "<") != 1 {
+		return 0, fmt.Errorf("invalid code point %s", s)
+	}
+	c, err := strconv.ParseUint(s[2:len(s)-1], 16, 32)
+	if err != nil || !utf8.ValidRune(rune(c)) {
+		return 0, fmt.Errorf("invalid code point %s", s)
+	}
+	return rune(c), nil
+}
+
+func parseUCMBytes(s string) (b []byte, err error) {
+	for _, h := range strings.Split(s, `\x`)[1:] {
+		v, exx := strconv.ParseUint(h, 16, 8)
+		if exx != nil {
+			return nil, fmt.Errorf("invalid byte sequence %s", s)
+		}
+		b = append(b, byte(v))
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("invalid byte sequence %s", s)
+	}
+	return
+}
+
+// charmapDir returns where mapping files are picked up without
+// --charmap-file, tests stub it.
+var charmapDir = func() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "transcode", "charmaps")
+}
+
+func loadCharmaps(files []string) error {
+	if dir := charmapDir(); dir != "" {
+		entries, err := os.ReadDir(dir)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("read charmap directory %s failed: %w", dir, err)
+		}
+		var found []string
+		for _, e := range entries {
+			switch strings.ToLower(filepath.Ext(e.Name())) {
+			case ".txt", ".ucm":
+				found = append(found, filepath.Join(dir, e.Name()))
+			}
+		}
+		files = append(found, files...)
+	}
+	for _, f := range files {
+		t, err := loadCharmapFile(f)
+		if err != nil {
+			return err
+		}
+		if err = registerCharmap(t); err != nil {
+			return fmt.Errorf("load %s failed: %w", f, err)
+		}
+	}
+	return nil
+}
+
+func registerCharmap(t *tableEncoding) error {
+	if _, ok := lookupEncoding(t.name); ok {
+		return fmt.Errorf("encoding %s already exists", t.name)
+	}
+	ascii := true
+	for i := rune(0); i < utf8.RuneSelf; i++ {
+		if b, ok := t.encode[i]; !ok || rune(b) != i || t.decode[i] != i {
+			ascii = false
+			break
+		}
+	}
+	registry = append(registry, encodingInfo{
+		Name:     t.name,
+		MinBytes: 1,
+		MaxBytes: 1,
+		ASCII:    ascii,
+		Group:    "Custom",
+		index:    "custom",
+		enc:      t,
+	})
+	registryIndex[t.name] = &registry[len(registry)-1]
+	return nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"golang.org/x/text/encoding"
+)
+
+const testTXT = `#
+#	Name:     test vendor code page to Unicode table
+#
+0x41	0x0041	# LATIN CAPITAL LETTER A
+0x42	0x0042	# LATIN CAPITAL LETTER B
+0x3F	0x003F	# QUESTION MARK
+0x80	0x0416	# CYRILLIC CAPITAL LETTER ZHE
+0x81	0x20AC	# EURO SIGN
+0x82		# UNDEFINED
+`
+
+const testUCM = `<code_set_name>               "VENDOR-42"
+<mb_cur_max>                  1
+<mb_min_len>                  1
+<subchar>                     \x3F
+CHARMAP
+<U0041> \x41 |0
+<U003F> \x3F |0
+<U00C4> \x80 |0
+<U00E4> \x80 |1
+<U2122> \x81 |3
+END CHARMAP
+`
+
+func TestLoadCharmapFile(t *testing.T) {
+	dir := t.TempDir()
+	txt := filepath.Join(dir, "Vendor-7.TXT")
+	ucm := filepath.Join(dir, "vendor.ucm")
+	os.WriteFile(txt, []byte(testTXT), 0644)
+	os.WriteFile(ucm, []byte(testUCM), 0644)
+
+	var cases = []struct {
+		file   string
+		name   string
+		decode string
+		encode string
+		want   string
+	}{
+		{txt, "vendor-7", "AB\x80\x81\x82", "AB\x80\x81?", "ABЖ€�"},
+		{ucm, "vendor-42", "A\x80\x81", "A\x80?", "AÄ™"},
+	}
+	for _, c := range cases {
+		enc, err := loadCharmapFile(c.file)
+		if err != nil {
+			t.Fatalf("load %s: %s", c.file, err)
+		}
+		if enc.name != c.name {
+			t.Errorf("%s: name %q, want %q", c.file, enc.name, c.name)
+		}
+		got, err := enc.NewDecoder().String(c.decode)
+		if err != nil || got != c.want {
+			t.Errorf("%s: decode %q got %q (%v), want %q", c.file, c.decode, got, err, c.want)
+		}
+		if _, err = enc.NewEncoder().String(c.want); err == nil {
+			t.Errorf("%s: encoding %q should fail", c.file, c.want)
+		}
+		got, err = encoding.ReplaceUnsupported(enc.NewEncoder()).String(c.want)
+		if err != nil || got != c.encode {
+			t.Errorf("%s: encode %q got %q (%v), want %q", c.file, c.want, got, err, c.encode)
+		}
+	}
+
+	restoreRegistry(t)
+	enc, _ := loadCharmapFile(ucm)
+	if got, _ := enc.NewEncoder().String("ä"); got != "\x80" {
+		t.Errorf("fallback mapping got %q, want %q", got, "\x80")
+	}
+	if err := registerCharmap(enc); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := parseEncoding("VENDOR-42"); err != nil || got != encoding.Encoding(enc) {
+		t.Errorf("parseEncoding(VENDOR-42) = %v, %v", got, err)
+	}
+	if err := registerCharmap(enc); err == nil {
+		t.Error("registering vendor-42 twice should fail")
+	}
+}
+
+// restoreRegistry drops the encodings a test registers when it ends.
+func restoreRegistry(t *testing.T) {
+	list, index := slices.Clone(registry), maps.Clone(registryIndex)
+	t.Cleanup(func() { registry, registryIndex = list, index })
+}
+
+func TestLoadCharmapsDir(t *testing.T) {
+	restoreRegistry(t)
+	dir := t.TempDir()
+	defer func(f func() string) { charmapDir = f }(charmapDir)
+	charmapDir = func() string { return dir }
+	os.WriteFile(filepath.Join(dir, "vendor-7.txt"), []byte("0x41\t0x0042\n"), 0644)
+	os.WriteFile(filepath.Join(dir, "readme.md"), []byte("not a mapping"), 0644)
+	if err := loadCharmaps(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := lookupEncoding("vendor-7"); !ok {
+		t.Error("vendor-7 from the charmap directory is not registered")
+	}
+}
+
+func TestLoadCharmapFileMultiByte(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "dbcs.txt")
+	os.WriteFile(file, []byte("0x8140\t0x3000\n"), 0644)
+	if _, err := loadCharmapFile(file); err == nil {
+		t.Error("multi-byte mapping file should be rejected")
+	}
+}
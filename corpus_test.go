@@ -0,0 +1,156 @@
+package main
+
+//go:generate go test -run TestCorpus -update
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding"
+)
+
+var update = flag.Bool("update", false, "regenerate the detection corpus in testfiles")
+
+// corpusLanguages is the number of languages written per encoding.
+const corpusLanguages = 4
+
+// unicodeLanguages are written in the Unicode encodings, which list none.
+var unicodeLanguages = []string{"en", "ru", "zh", "ar"}
+
+// corpusDir holds a directory of sample files per encoding.
+var corpusDir = filepath.Join("testfiles", "corpus")
+
+// corpusText is a sample text in testfiles/texts, the first line is a short
+// sentence and the rest a paragraph.
+type corpusText struct {
+	lang string
+	text string
+}
+
+func (t corpusText) sizes() map[string]string {
+	short, _, _ := strings.Cut(t.text, "\n")
+	return map[string]string{
+		"short":  short + "\n",
+		"medium": t.text,
+		"long":   strings.Repeat(t.text+"\n", 4),
+	}
+}
+
+func loadCorpusTexts(t *testing.T) []corpusText {
+	files, err := filepath.Glob(filepath.Join("testfiles", "texts", "*.txt"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no corpus texts: %v", err)
+	}
+	var texts []corpusText
+	for _, f := range files {
+		dat, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		texts = append(texts, corpusText{strings.TrimSuffix(filepath.Base(f), ".txt"), string(dat)})
+	}
+	return texts
+}
+
+// corpusFiles returns the corpus files of every encoding keyed by their path,
+// written in the languages of the encoding that it can encode, and English.
+func corpusFiles(t *testing.T) map[string][]byte {
+	texts := loadCorpusTexts(t)
+	files := make(map[string][]byte)
+	for _, e := range registry {
+		if e.index == "custom" || e.Name == "replacement" {
+			continue // not a real encoding, it decodes everything to U+FFFD
+		}
+		enc, err := e.encoding()
+		if err != nil {
+			t.Fatalf("%s: %s", e.Name, err)
+		}
+		langs := e.Languages
+		if e.Group == "Unicode" {
+			langs = unicodeLanguages
+		}
+		if !slices.Contains(langs, "en") {
+			langs = append(slices.Clone(langs), "en")
+		}
+		var picked []corpusText
+		for _, l := range langs {
+			for _, c := range texts {
+				if c.lang == l || strings.HasPrefix(c.lang, l+"-") {
+					picked = append(picked, c)
+				}
+			}
+		}
+		n := 0
+		for _, c := range picked {
+			if n == corpusLanguages {
+				break
+			}
+			sizes := c.sizes()
+			encoded := make(map[string][]byte)
+			for size, text := range sizes {
+				if encoded[size], err = encodeStrict(enc, text); err != nil {
+					break
+				}
+			}
+			if err != nil {
+				continue
+			}
+			for size, dat := range encoded {
+				files[filepath.Join(corpusDir, e.Name, c.lang+"-"+size+".txt")] = dat
+			}
+			n++
+		}
+		if n == 0 {
+			t.Errorf("%s: no text can be encoded", e.Name)
+		}
+	}
+	return files
+}
+
+// encodeStrict encodes text and checks it decodes back unchanged.
+func encodeStrict(enc encoding.Encoding, text string) ([]byte, error) {
+	dat, err := enc.NewEncoder().Bytes([]byte(text))
+	if err != nil {
+		return nil, err
+	}
+	back, err := enc.NewDecoder().Bytes(dat)
+	if err != nil {
+		return nil, err
+	}
+	if string(back) != text {
+		return nil, errors.New("text does not round-trip")
+	}
+	return dat, nil
+}
+
+func TestCorpus(t *testing.T) {
+	files := corpusFiles(t)
+	if *update {
+		os.RemoveAll(corpusDir)
+		for f, dat := range files {
+			if err := os.MkdirAll(filepath.Dir(f), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(f, dat, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+	for f, want := range files {
+		got, err := os.ReadFile(f)
+		if err != nil {
+			t.Errorf("%s, run go generate to update the corpus", err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run go generate to update the corpus", f)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// csvPresets set the target encoding and delimiter Excel opens without an
+// import dialog, unless -t or --output-delimiter are given.
+var csvPresets = map[string]struct {
+	target    string
+	delimiter string
+}{
+	"excel-csv": {"utf-8-bom", ""},
+	"excel-tsv": {"utf-16le-bom", "tab"},
+}
+
+// csvDelimiters are sniffed from the first record, in order of preference.
+const csvDelimiters = ",;\t|"
+
+// applyPreset turns on CSV mode with the settings of the preset.
+func (c *trans) applyPreset() {
+	p, ok := csvPresets[c.Preset]
+	if !ok {
+		return
+	}
+	c.CSV = true
+	if c.TargetEncoding == "" {
+		c.TargetEncoding = p.target
+	}
+	if c.OutputDelimiter == "" {
+		c.OutputDelimiter = p.delimiter
+	}
+}
+
+func parseDelimiter(s string) (rune, error) {
+	switch s {
+	case "tab", `\t`:
+		return '\t', nil
+	}
+	r, n := utf8.DecodeRuneInString(s)
+	if n == 0 || n != len(s) || r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError {
+		return 0, fmt.Errorf("invalid delimiter %q", s)
+	}
+	return r, nil
+}
+
+// sniffDelimiter picks the delimiter found most often in the first record.
+func sniffDelimiter(text []byte) rune {
+	line, _, _ := bytes.Cut(text, []byte("\n"))
+	best, most := ',', 0
+	for _, d := range csvDelimiters {
+		if n := countUnquoted(line, byte(d)); n > most {
+			best, most = d, n
+		}
+	}
+	return best
+}
+
+func countUnquoted(line []byte, d byte) (n int) {
+	quoted := false
+	for _, b := range line {
+		switch {
+		case b == '"':
+			quoted = !quoted
+		case b == d && !quoted:
+			n++
+		}
+	}
+	return
+}
+
+// csvRow is the field count of a record and the line it starts on.
+type csvRow struct {
+	line   int
+	fields int
+}
+
+func csvRows(text []byte, delim rune) (rows []csvRow, records [][]string, err error) {
+	r := csv.NewReader(bytes.NewReader(text))
+	r.Comma, r.FieldsPerRecord, r.LazyQuotes = delim, -1, true
+	for {
+		rec, exx := r.Read()
+		if exx == io.EOF {
+			return
+		}
+		if exx != nil {
+			return nil, nil, exx
+		}
+		line, _ := r.FieldPos(0)
+		rows = append(rows, csvRow{line, len(rec)})
+		records = append(records, rec)
+	}
+}
+
+// asciiCompatible reports encodings that keep delimiters, quotes and line
+// breaks as single bytes, where the fields of the raw input can be counted.
+func asciiCompatible(enc encoding.Encoding) bool {
+	const probe = ",;\t|\"\r\n"
+	dat, err := enc.NewEncoder().Bytes([]byte(probe))
+	return err == nil && string(dat) == probe
+}
+
+// convertCSV converts a CSV or TSV file and checks it has the same rows and
+// fields after conversion. A lead byte of a multibyte sequence right before a
+// delimiter or quote, as in truncated fields, makes the decoder swallow it.
+func (c *trans) convertCSV(w io.Writer, r io.Reader, f string) (err error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+	text, _, err := transform.Bytes(c.decoder(), raw)
+	if err != nil {
+		return fmt.Errorf("decode as %s failed: %w", c.source, err)
+	}
+	delim := sniffDelimiter(text)
+	if c.Delimiter != "" {
+		delim, _ = parseDelimiter(c.Delimiter)
+	}
+	outDelim := delim
+	if c.OutputDelimiter != "" {
+		outDelim, _ = parseDelimiter(c.OutputDelimiter)
+	}
+	// trail bytes of double-byte encodings start at 0x40, above , ; and tab
+	var before []csvRow
+	if asciiCompatible(c.source) && delim < 0x40 {
+		before, _, err = csvRows(raw, delim)
+	} else {
+		before, _, err = csvRows(text, delim)
+	}
+	if err != nil {
+		return fmt.Errorf("parse %s failed: %w", f, err)
+	}
+	if outDelim != delim {
+		_, records, exx := csvRows(text, delim)
+		if exx != nil {
+			return fmt.Errorf("parse %s failed: %w", f, exx)
+		}
+		var buf bytes.Buffer
+		cw := csv.NewWriter(&buf)
+		cw.Comma, cw.UseCRLF = outDelim, bytes.Contains(text, []byte("\r\n"))
+		cw.WriteAll(records)
+		if err = cw.Error(); err != nil {
+			return
+		}
+		text = buf.Bytes()
+	}
+	out, err := c.target.NewEncoder().Bytes(text)
+	if err != nil {
+		return fmt.Errorf("encode as %s failed: %w", c.target, err)
+	}
+	back, err := c.target.NewDecoder().Bytes(out)
+	if err != nil {
+		return
+	}
+	after, _, err := csvRows(back, outDelim)
+	if err != nil {
+		return fmt.Errorf("parse converted %s failed: %w", f, err)
+	}
+	if changed := compareRows(f, before, after); changed > 0 {
+		return fmt.Errorf("field count changed in %d rows, the source is likely not %s", changed, c.source)
+	}
+	_, err = w.Write(out)
+	return
+}
+
+// compareRows logs the rows whose field count changed and returns how many.
+func compareRows(f string, before, after []csvRow) (changed int) {
+	for i, b := range before {
+		switch {
+		case i >= len(after):
+			log.Printf("row %d at line %d of %s is missing after conversion", i+1, b.line, f)
+		case after[i].fields != b.fields:
+			log.Printf("row %d at line %d of %s has %d fields after conversion, %d before", i+1, b.line, f, after[i].fields, b.fields)
+		default:
+			continue
+		}
+		changed++
+	}
+	if n := len(after) - len(before); n > 0 {
+		log.Printf("%s has %d more rows after conversion", f, n)
+		changed += n
+	}
+	return
+}
+
+// checkCSV validates the CSV flags before any file is processed.
+func (c *trans) checkCSV() error {
+	for _, d := range []string{c.Delimiter, c.OutputDelimiter} {
+		if d == "" {
+			continue
+		}
+		if _, err := parseDelimiter(d); err != nil {
+			return err
+		}
+	}
+	if !c.CSV && (c.Delimiter != "" || c.OutputDelimiter != "") {
+		return errors.New("--delimiter and --output-delimiter need --csv")
+	}
+	return nil
+}
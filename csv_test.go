@@ -0,0 +1,95 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSniffDelimiter(t *testing.T) {
+	tests := []struct {
+		text string
+		want rune
+	}{
+		{"a,b,c\n1,2,3\n", ','},
+		{"a;b;c\n", ';'},
+		{"a\tb\tc\n", '\t'},
+		{"a|b|c\n", '|'},
+		{`"x;y;z",b` + "\n", ','},
+		{"single\n", ','},
+	}
+	for _, tt := range tests {
+		if got := sniffDelimiter([]byte(tt.text)); got != tt.want {
+			t.Errorf("sniffDelimiter(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestConvertCSV(t *testing.T) {
+	quietLog(t)
+	gbk := []byte("id,name,note\r\n1,\xc4\xe3\xba\xc3,\"a, b\"\r\n")
+	tests := []struct {
+		name  string
+		stdin []byte
+		args  []string
+		out   string
+		err   string
+	}{
+		{"csv", gbk, []string{"--csv", "-s", "gbk"}, "id,name,note\r\n1,你好,\"a, b\"\r\n", ""},
+		{"excel-csv", gbk, []string{"--preset", "excel-csv", "-s", "gbk"}, "\xef\xbb\xbfid,name,note\r\n1,你好,\"a, b\"\r\n", ""},
+		{"excel-csv keeps explicit utf8", gbk, []string{"--preset", "excel-csv", "-s", "gbk", "-t", "utf8"}, "id,name,note\r\n1,你好,\"a, b\"\r\n", ""},
+		{"excel-csv keeps target", gbk, []string{"--preset", "excel-csv", "-s", "gbk", "-t", "gb18030"}, string(gbk), ""},
+		{"re-delimited", gbk, []string{"--csv", "-s", "gbk", "--output-delimiter", ";"}, "id;name;note\r\n1;你好;a, b\r\n", ""},
+		{"semicolons sniffed", []byte("a;b\n\"x;y\";z\n"), []string{"--csv", "-s", "utf8", "--output-delimiter", ","}, "a,b\nx;y,z\n", ""},
+		{"swallowed delimiters", []byte("a,b\n1\x1b$B,2\n"), []string{"--csv", "-s", "iso-2022-jp"}, "", "field count changed in 1 rows"},
+		{"invalid delimiter", gbk, []string{"--csv", "--delimiter", "ab"}, "", `invalid delimiter "ab"`},
+		{"delimiter without csv", gbk, []string{"--delimiter", ";"}, "", "need --csv"},
+		{"unknown preset", gbk, []string{"--preset", "lotus"}, "", "--preset must be one of"},
+	}
+	for _, tt := range tests {
+		out, err := transcode(t, tt.stdin, tt.args...)
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("%s: %s", tt.name, err)
+		case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.err)
+		case string(out) != tt.out:
+			t.Errorf("%s: got %q, want %q", tt.name, out, tt.out)
+		}
+	}
+}
+
+func TestConvertCSVExcelTSV(t *testing.T) {
+	quietLog(t)
+	f := filepath.Join(t.TempDir(), "data.csv")
+	if err := os.WriteFile(f, []byte("id,name\n1,\"Caf\xe9, Bar\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := transcode(t, nil, "--preset", "excel-tsv", "-s", "windows-1252", "-w", f); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "\xff\xfei\x00d\x00\t\x00n\x00a\x00m\x00e\x00\n\x001\x00\t\x00C\x00a\x00f\x00\xe9\x00,\x00 \x00B\x00a\x00r\x00\n\x00"
+	if string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestConvertCSVUnchangedOnError(t *testing.T) {
+	quietLog(t)
+	f := filepath.Join(t.TempDir(), "data.csv")
+	in := "a,b\n1\x1b$B,2\n"
+	if err := os.WriteFile(f, []byte(in), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := transcode(t, nil, "--csv", "-s", "iso-2022-jp", "-w", f); err == nil {
+		t.Error("converted with changed rows")
+	}
+	if got, _ := os.ReadFile(f); string(got) != in {
+		t.Errorf("file changed to %q", got)
+	}
+}
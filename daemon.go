@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/encoding"
+
+	"github.com/gonejack/transcode/chardet"
+)
+
+// daemon answers JSON-RPC 2.0 requests, one message per line, so editors
+// and build tools detect and convert without spawning a process each time.
+// Every backend compiled in runs once at start, so one that loads lazily,
+// like the charamel wasm runtime of -tags charamel_wazero, is ready for the
+// first request and reused by the later ones.
+type daemon struct {
+	Stdio   bool `name:"stdio" required:"" help:"Speak JSON-RPC 2.0 over stdin and stdout, one message per line."`
+	Workers int  `name:"workers" default:"8" help:"Process at most this many requests at once."`
+	detection
+}
+
+const (
+	rpcParseError     = -32700
+	rpcInvalidRequest = -32600
+	rpcNoMethod       = -32601
+	rpcInvalidParams  = -32602
+	rpcFailed         = -32000
+)
+
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// rpcParams are the parameters of detect and convert, data is base64 in JSON.
+// The detection fields override the flags of the daemon.
+type rpcParams struct {
+	Data          []byte   `json:"data"`
+	From          string   `json:"from"`
+	To            string   `json:"to"`
+	TrustDeclared string   `json:"trustDeclared"`
+	Lang          *string  `json:"lang"`
+	Prefer        []string `json:"prefer"`
+	Only          []string `json:"only"`
+	Superset      *bool    `json:"superset"`
+}
+
+type convertResult struct {
+	Data     []byte          `json:"data"`
+	Source   string          `json:"source"`
+	Detected *chardet.Result `json:"detected,omitempty"`
+}
+
+func (c *daemon) Run() error {
+	if err := c.detection.check(); err != nil {
+		return err
+	}
+	// load libraries and runtimes of the backends before the first request
+	for _, b := range chardet.Backends() {
+		b.Detect([]byte("warm up"))
+	}
+	return c.serve(os.Stdin, os.Stdout)
+}
+
+// serve reads requests from r until it ends and writes responses to w in the
+// order they complete, requests run concurrently.
+func (c *daemon) serve(r io.Reader, w io.Writer) error {
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, max(c.Workers, 1))
+		enc  = json.NewEncoder(w)
+		werr error
+	)
+	reply := func(resp rpcResponse) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err := enc.Encode(resp); err != nil && werr == nil {
+			werr = err
+		}
+	}
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			var req rpcRequest
+			if exx := json.Unmarshal(line, &req); exx != nil {
+				reply(rpcResponse{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &rpcError{rpcParseError, exx.Error()}})
+			} else {
+				sem <- struct{}{}
+				wg.Add(1)
+				go func() {
+					defer func() { <-sem; wg.Done() }()
+					result, exx := c.call(req)
+					resp := rpcResponse{JSONRPC: "2.0", ID: req.ID, Result: result}
+					if exx != nil {
+						resp.Result, resp.Error = nil, asRPCError(exx)
+					}
+					switch {
+					case req.ID != nil:
+						reply(resp)
+					case resp.Error != nil && resp.Error.Code == rpcInvalidRequest:
+						resp.ID = json.RawMessage("null")
+						reply(resp)
+					}
+					// other requests without id are notifications
+				}()
+			}
+		}
+		if err != nil {
+			wg.Wait()
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return cmp.Or(err, werr)
+		}
+	}
+}
+
+func asRPCError(err error) *rpcError {
+	if e := (*rpcError)(nil); errors.As(err, &e) {
+		return e
+	}
+	return &rpcError{rpcFailed, err.Error()}
+}
+
+func (c *daemon) call(req rpcRequest) (any, error) {
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return nil, &rpcError{rpcInvalidRequest, "not a JSON-RPC 2.0 request"}
+	}
+	if req.Method == "listEncodings" {
+		return registry, nil
+	}
+	var p rpcParams
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &rpcError{rpcInvalidParams, err.Error()}
+		}
+	}
+	d, err := p.detection(c.detection)
+	if err != nil {
+		return nil, &rpcError{rpcInvalidParams, err.Error()}
+	}
+	switch req.Method {
+	case "detect":
+		if len(p.Data) == 0 {
+			return nil, &rpcError{rpcInvalidParams, "no data"}
+		}
+		return chardet.Detect(p.Data[:min(len(p.Data), 2048)], d.detectOptions()...)
+	case "convert":
+		return p.convert(d)
+	default:
+		return nil, &rpcError{rpcNoMethod, fmt.Sprintf("unknown method %s", req.Method)}
+	}
+}
+
+func (p *rpcParams) detection(d detection) (*detection, error) {
+	switch p.TrustDeclared {
+	case "":
+	case "always", "verify", "never":
+		d.TrustDeclared = p.TrustDeclared
+	default:
+		return nil, fmt.Errorf("invalid trustDeclared %s, one of always,verify,never", p.TrustDeclared)
+	}
+	if p.Lang != nil {
+		d.Lang = *p.Lang
+	}
+	if p.Prefer != nil {
+		d.Prefer = p.Prefer
+	}
+	if p.Only != nil {
+		d.Only = p.Only
+	}
+	if p.Superset != nil {
+		d.Superset = *p.Superset
+	}
+	return &d, d.check()
+}
+
+func (p *rpcParams) convert(d *detection) (res convertResult, err error) {
+	from, to := cmp.Or(p.From, "auto"), cmp.Or(p.To, "utf-8")
+	target, err := parseEncoding(to)
+	if err != nil {
+		return res, &rpcError{rpcInvalidParams, fmt.Sprintf("parse target-encoding %s failed: %s", to, err)}
+	}
+	var source encoding.Encoding
+	if strings.EqualFold(from, "auto") {
+		if len(p.Data) == 0 {
+			return res, errors.New("cannot determine source-encoding of no data")
+		}
+		r, err := chardet.Detect(p.Data[:min(len(p.Data), 2048)], d.detectOptions()...)
+		if err == nil {
+			source, err = parseEncoding(r.Encoding)
+		}
+		if err != nil {
+			return res, fmt.Errorf("cannot determine source-encoding: %w", err)
+		}
+		from, res.Detected = r.Encoding, &r
+	} else if source, err = parseEncoding(from); err != nil {
+		return res, &rpcError{rpcInvalidParams, fmt.Sprintf("parse source-encoding %s failed: %s", from, err)}
+	}
+	var out bytes.Buffer
+	if err = convert(&out, bytes.NewReader(p.Data), source, target); err != nil {
+		return res, err
+	}
+	res.Data, res.Source = out.Bytes(), from
+	if res.Data == nil {
+		res.Data = []byte{}
+	}
+	return res, nil
+}
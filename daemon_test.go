@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDaemon(t *testing.T) {
+	sample, err := os.ReadFile(filepath.Join(corpusDir, "koi8-r", "ru-medium.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, _ := parseEncoding("koi8-r")
+	text, _ := enc.NewDecoder().Bytes(sample)
+	data, _ := json.Marshal(sample)
+
+	tests := []struct {
+		request string
+		result  string
+		code    int
+	}{
+		{`{"jsonrpc":"2.0","id":1,"method":"detect","params":{"data":%s}}`, `{"encoding":"koi8-r","language":"ru","script":"Cyrl"}`, 0},
+		{`{"jsonrpc":"2.0","id":2,"method":"detect","params":{"data":%s,"only":["windows-1251"]}}`, `{"encoding":"windows-1251","script":"Cyrl"}`, 0},
+		{`{"jsonrpc":"2.0","id":3,"method":"convert","params":{"data":%s}}`, fmt.Sprintf(`{"data":%s,"source":"koi8-r","detected":{"encoding":"koi8-r","language":"ru","script":"Cyrl"}}`, mustJSON(text)), 0},
+		{`{"jsonrpc":"2.0","id":4,"method":"convert","params":{"data":"YWI=","from":"utf8","to":"utf-16le"}}`, `{"data":"YQBiAA==","source":"utf8"}`, 0},
+		{`{"jsonrpc":"2.0","id":5,"method":"convert","params":{"data":"5L2g","from":"utf8","to":"latin1"}}`, "", rpcFailed},
+		{`{"jsonrpc":"2.0","id":6,"method":"convert","params":{"data":"YWI=","to":"no-such"}}`, "", rpcInvalidParams},
+		{`{"jsonrpc":"2.0","id":7,"method":"detect","params":{"data":"YWI=","lang":"xx"}}`, "", rpcInvalidParams},
+		{`{"jsonrpc":"2.0","id":8,"method":"detect","params":{"data":true}}`, "", rpcInvalidParams},
+		{`{"jsonrpc":"2.0","id":9,"method":"nope"}`, "", rpcNoMethod},
+		{`{"id":10,"method":"detect"}`, "", rpcInvalidRequest},
+	}
+	var in bytes.Buffer
+	for _, tt := range tests {
+		req := tt.request
+		if strings.Contains(req, "%s") {
+			req = fmt.Sprintf(req, data)
+		}
+		in.WriteString(req + "\n")
+	}
+	in.WriteString(`{"jsonrpc":"2.0","method":"detect","params":{"data":"YWI="}}` + "\n") // a notification
+	in.WriteString("not json\n")
+
+	var out bytes.Buffer
+	if err := (&daemon{Workers: 4, detection: detection{TrustDeclared: "verify"}}).serve(&in, &out); err != nil {
+		t.Fatal(err)
+	}
+	responses := make(map[string]rpcResponse)
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var resp struct {
+			rpcResponse
+			Result json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal([]byte(line), &resp); err != nil {
+			t.Fatalf("%s: %s", line, err)
+		}
+		resp.rpcResponse.Result = resp.Result
+		responses[string(resp.ID)] = resp.rpcResponse
+	}
+	if len(responses) != len(tests)+1 {
+		t.Errorf("got %d responses, want %d:\n%s", len(responses), len(tests)+1, out.String())
+	}
+	if resp := responses["null"]; resp.Error == nil || resp.Error.Code != rpcParseError {
+		t.Errorf("not json: got %+v, want a parse error", resp)
+	}
+	for i, tt := range tests {
+		resp, ok := responses[fmt.Sprint(i+1)]
+		switch {
+		case !ok:
+			t.Errorf("request %d: no response", i+1)
+		case tt.code != 0 && (resp.Error == nil || resp.Error.Code != tt.code):
+			t.Errorf("request %d: got %+v, want error %d", i+1, resp, tt.code)
+		case tt.code == 0 && resp.Error != nil:
+			t.Errorf("request %d: %s", i+1, resp.Error.Message)
+		case tt.code == 0 && string(resp.Result.(json.RawMessage)) != tt.result:
+			t.Errorf("request %d: got %s, want %s", i+1, resp.Result, tt.result)
+		}
+	}
+}
+
+func mustJSON(v any) string {
+	dat, _ := json.Marshal(v)
+	return string(dat)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+	"unicode"
+)
+
+// fuzzEncodings are the encodings FuzzConvert picks from by index.
+var fuzzEncodings = func() (names []string) {
+	for _, e := range registry {
+		if e.index != "custom" && e.Name != "replacement" {
+			names = append(names, e.Name)
+		}
+	}
+	return
+}()
+
+// unrepresentable reports text that the encoder accepts but the encoding
+// cannot carry.
+func unrepresentable(name string, text []byte) bool {
+	switch name {
+	case "iso-2022-jp":
+		// the characters that switch character sets
+		return bytes.ContainsAny(text, "\x1b\x0e\x0f")
+	case "gbk", "gb18030":
+		// x/text maps private use characters to wrong four-byte sequences
+		return bytes.ContainsFunc(text, func(r rune) bool { return unicode.Is(unicode.Co, r) })
+	}
+	return false
+}
+
+// FuzzConvert converts arbitrary bytes between two encodings. It must not
+// panic or hang, and text it manages to convert must decode from the target
+// encoding to what it decoded to from the source.
+func FuzzConvert(f *testing.F) {
+	utf8 := uint8(slices.Index(fuzzEncodings, "utf-8"))
+	files, err := filepath.Glob(filepath.Join(corpusDir, "*", "*-short.txt"))
+	if err != nil || len(files) == 0 {
+		f.Fatalf("no corpus files: %v", err)
+	}
+	for _, file := range files {
+		dat, err := os.ReadFile(file)
+		if err != nil {
+			f.Fatal(err)
+		}
+		source := uint8(slices.Index(fuzzEncodings, filepath.Base(filepath.Dir(file))))
+		f.Add(dat, source, utf8)
+		f.Add(dat, source, source)
+	}
+	f.Fuzz(func(t *testing.T, dat []byte, source, target uint8) {
+		name := fuzzEncodings[int(target)%len(fuzzEncodings)]
+		src, _ := parseEncoding(fuzzEncodings[int(source)%len(fuzzEncodings)])
+		dst, _ := parseEncoding(name)
+		var out bytes.Buffer
+		done := make(chan error)
+		go func() {
+			done <- convert(&out, bytes.NewReader(dat), src, dst)
+		}()
+		select {
+		case err := <-done:
+			if err != nil {
+				return
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("converting %q from %s to %s did not finish", dat, src, dst)
+		}
+		want, err := src.NewDecoder().Bytes(dat)
+		if err != nil {
+			t.Fatalf("decode %q from %s: %v", dat, src, err)
+		}
+		if unrepresentable(name, want) {
+			return
+		}
+		got, err := dst.NewDecoder().Bytes(out.Bytes())
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("%q from %s to %s is %q, which decodes to %q (%v), want %q", dat, src, dst, out.Bytes(), got, err, want)
+		}
+	})
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gonejack/transcode/chardet"
+)
+
+// textconv is a textconv driver for git diff, set up with
+//
+//	git config diff.transcode.textconv "transcode git-textconv"
+//	echo '*.txt diff=transcode' >> .gitattributes
+//
+// It writes the file as UTF-8 and never fails a diff: binary files and files
+// of unknown encoding are written as they are.
+type textconv struct {
+	File string `arg:"" type:"existingfile" help:"File to show, as passed by git."`
+	detection
+}
+
+func (c *textconv) Run() (err error) {
+	if err = c.detection.check(); err != nil {
+		return
+	}
+	dat, err := os.ReadFile(c.File)
+	if err != nil {
+		return
+	}
+	if len(dat) > 0 && !looksBinary(dat) {
+		if coding, exx := chardet.DetectEncoding(dat[:min(len(dat), 2048)], c.detectOptions()...); exx == nil {
+			if enc, exx := parseEncoding(coding); exx == nil {
+				if text, exx := enc.NewDecoder().Bytes(dat); exx == nil {
+					dat = text
+				}
+			}
+		}
+	}
+	_, err = os.Stdout.Write(dat)
+	return
+}
+
+// checker checks files have the required encoding, byte order mark and line
+// endings, by default the files staged in git, for pre-commit hooks. It
+// exits with status 1 when any file does not conform.
+type checker struct {
+	Require string   `name:"require" default:"utf-8" help:"Require this encoding, ASCII files conform to every ASCII compatible one."`
+	BOM     string   `name:"bom" enum:"any,forbid,require" default:"forbid" help:"Forbid or require a byte order mark, one of any,forbid,require."`
+	EOL     string   `name:"eol" enum:"any,lf,crlf" default:"any" help:"Require line endings, one of any,lf,crlf."`
+	Format  string   `name:"format" enum:"text,json" default:"text" help:"Set output format of the report, one of text,json."`
+	Path    []string `arg:"" optional:"" type:"path" help:"Files or directories to check, default the files staged in git."`
+	detection
+
+	require string
+	ascii   bool // ASCII files conform
+}
+
+// checkReport is the result of checking one file.
+type checkReport struct {
+	Path     string   `json:"path"`
+	Encoding string   `json:"encoding"`
+	BOM      bool     `json:"bom"`
+	EOL      string   `json:"eol"`
+	Problems []string `json:"problems,omitempty"`
+}
+
+func (c *checker) Run() (err error) {
+	if err = c.detection.check(); err != nil {
+		return
+	}
+	info, ok := lookupEncoding(c.Require)
+	if !ok {
+		return fmt.Errorf("invalid encoding: %s", c.Require)
+	}
+	enc, err := info.encoding()
+	if err != nil {
+		return fmt.Errorf("invalid encoding: %s", c.Require)
+	}
+	c.require = chardet.Canonical(strings.TrimSuffix(c.Require, "-bom"))
+	c.ascii = asciiCompatible(enc)
+
+	var reports []checkReport
+	if len(c.Path) == 0 {
+		reports, err = c.checkStaged()
+	} else {
+		reports, err = c.checkPaths()
+	}
+	if err != nil {
+		return
+	}
+	failed := 0
+	for _, r := range reports {
+		if len(r.Problems) > 0 {
+			failed++
+		}
+	}
+	if err = c.print(os.Stdout, reports, failed); err != nil {
+		return
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files do not conform", failed, len(reports))
+	}
+	return
+}
+
+// checkStaged checks the staged content of added, copied, modified and
+// renamed files, not the one in the work tree.
+func (c *checker) checkStaged() (reports []checkReport, err error) {
+	if _, err = git("rev-parse", "--git-dir"); err != nil {
+		return
+	}
+	out, err := git("diff", "--cached", "--name-only", "-z", "--diff-filter=ACMR")
+	if err != nil {
+		return nil, fmt.Errorf("list staged files failed: %w", err)
+	}
+	for _, name := range strings.Split(strings.TrimSuffix(string(out), "\x00"), "\x00") {
+		if name == "" {
+			continue
+		}
+		dat, err := git("show", ":"+name)
+		if err != nil {
+			return nil, fmt.Errorf("read staged %s failed: %w", name, err)
+		}
+		reports = append(reports, c.check(name, dat))
+	}
+	return
+}
+
+// checkPaths checks files and the files in directories, skipping .git.
+func (c *checker) checkPaths() (reports []checkReport, err error) {
+	for _, p := range c.Path {
+		err = filepath.WalkDir(p, func(path string, d fs.DirEntry, err error) error {
+			switch {
+			case err != nil:
+				return err
+			case d.IsDir() && d.Name() == ".git":
+				return filepath.SkipDir
+			case !d.Type().IsRegular():
+				return nil
+			}
+			dat, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			reports = append(reports, c.check(path, dat))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// check reports the encoding, byte order mark and line endings of a file.
+// A file is UTF-8 only when all of it is valid, other encodings are detected.
+func (c *checker) check(name string, dat []byte) (r checkReport) {
+	r.Path = name
+	if looksBinary(dat) {
+		r.Encoding, r.EOL = "binary", "none"
+		return
+	}
+	coding, n := chardet.SniffBOM(dat)
+	r.BOM = n > 0
+	switch {
+	case r.BOM:
+		r.Encoding = strings.TrimSuffix(coding, "-bom")
+	case isASCII(string(dat)):
+		r.Encoding = "ascii"
+	case utf8.Valid(dat):
+		r.Encoding = "utf-8"
+	default:
+		r.Encoding = "unknown"
+		if coding, err := chardet.DetectEncoding(dat, c.detectOptions()...); err == nil && coding != "utf-8" && coding != "ascii" {
+			r.Encoding = coding
+		}
+	}
+	text := dat
+	if enc, err := parseEncoding(r.Encoding); err == nil && r.Encoding != "ascii" {
+		if dec, err := enc.NewDecoder().Bytes(dat[n:]); err == nil {
+			text = dec
+		}
+	}
+	r.EOL = lineEndings(text)
+
+	switch {
+	case r.Encoding == c.require:
+	case c.require == "ascii": // every other encoding extends it
+		r.Problems = append(r.Problems, fmt.Sprintf("encoding %s, want %s", r.Encoding, c.require))
+	case slices.Contains(chardet.Supersets(r.Encoding), c.require):
+	case r.Encoding == "ascii" && c.ascii:
+	default:
+		r.Problems = append(r.Problems, fmt.Sprintf("encoding %s, want %s", r.Encoding, c.require))
+	}
+	switch {
+	case r.BOM && c.BOM == "forbid":
+		r.Problems = append(r.Problems, "has a byte order mark")
+	case !r.BOM && c.BOM == "require":
+		r.Problems = append(r.Problems, "has no byte order mark")
+	}
+	if c.EOL != "any" && r.EOL != "none" && r.EOL != c.EOL {
+		r.Problems = append(r.Problems, fmt.Sprintf("%s line endings, want %s", r.EOL, c.EOL))
+	}
+	return
+}
+
+// print writes the files that do not conform and a summary, or with --format
+// json every file.
+func (c *checker) print(w io.Writer, reports []checkReport, failed int) error {
+	if c.Format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			Require string        `json:"require"`
+			Checked int           `json:"checked"`
+			Failed  int           `json:"failed"`
+			Files   []checkReport `json:"files"`
+		}{c.require, len(reports), failed, reports})
+	}
+	for _, r := range reports {
+		if len(r.Problems) > 0 {
+			fmt.Fprintf(w, "%s: %s\n", r.Path, strings.Join(r.Problems, ", "))
+		}
+	}
+	_, err := fmt.Fprintf(w, "checked %d files, %d do not conform\n", len(reports), failed)
+	return err
+}
+
+// lineEndings returns lf, crlf or cr, mixed when there are several kinds or
+// none when there are no line breaks.
+func lineEndings(text []byte) string {
+	crlf := bytes.Count(text, []byte("\r\n"))
+	counts := map[string]int{
+		"lf":   bytes.Count(text, []byte("\n")) - crlf,
+		"crlf": crlf,
+		"cr":   bytes.Count(text, []byte("\r")) - crlf,
+	}
+	kind := "none"
+	for k, n := range counts {
+		switch {
+		case n == 0:
+		case kind != "none":
+			return "mixed"
+		default:
+			kind = k
+		}
+	}
+	return kind
+}
+
+// git runs a git command in the working directory and returns its output.
+func git(args ...string) ([]byte, error) {
+	out, err := exec.Command("git", args...).Output()
+	if ee := (*exec.ExitError)(nil); errors.As(err, &ee) && len(ee.Stderr) > 0 {
+		err = errors.New(strings.TrimSpace(string(ee.Stderr)))
+	}
+	return out, err
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+func TestGitTextconv(t *testing.T) {
+	quietLog(t)
+	zh := sampleText(t, "gb18030")
+	dir := t.TempDir()
+	tests := []struct {
+		name string
+		dat  []byte
+		want string
+	}{
+		{"gbk.txt", encodeString(t, simplifiedchinese.GBK, zh), zh},
+		{"utf8.txt", []byte(zh), zh},
+		{"binary.bin", binaryMember, string(binaryMember)},
+		{"empty.txt", nil, ""},
+	}
+	for _, tt := range tests {
+		f := filepath.Join(dir, tt.name)
+		if err := os.WriteFile(f, tt.dat, 0644); err != nil {
+			t.Fatal(err)
+		}
+		out, err := transcode(t, nil, "git-textconv", f)
+		if err != nil {
+			t.Errorf("git-textconv %s: %s", tt.name, err)
+		} else if string(out) != tt.want {
+			t.Errorf("git-textconv %s = %q, want %q", tt.name, out, tt.want)
+		}
+	}
+}
+
+// writeFiles writes files given as pairs of name and content to a temporary
+// directory.
+func writeFiles(t *testing.T, pairs ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for i := 0; i < len(pairs); i += 2 {
+		if err := os.WriteFile(filepath.Join(dir, pairs[i]), []byte(pairs[i+1]), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLineEndings(t *testing.T) {
+	tests := map[string]string{
+		"":          "none",
+		"a\nb\n":    "lf",
+		"a\r\nb":    "crlf",
+		"a\rb\r":    "cr",
+		"a\r\nb\nc": "mixed",
+	}
+	for in, want := range tests {
+		if got := lineEndings([]byte(in)); got != want {
+			t.Errorf("lineEndings(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	quietLog(t)
+	dir := writeFiles(t,
+		"ascii.txt", "hello\n",
+		"utf8.txt", "héllo wörld\n",
+		"bom.txt", "\xef\xbb\xbfhi\r\n",
+		"crlf.txt", "a\r\nb\r\n",
+	)
+	gbk := filepath.Join(dir, "gbk.txt")
+	if err := os.WriteFile(gbk, encodeString(t, simplifiedchinese.GBK, sampleText(t, "gb18030")), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := transcode(t, nil, "check", "--eol", "lf", dir)
+	if err == nil || err.Error() != "3 of 5 files do not conform" {
+		t.Errorf("check error = %v", err)
+	}
+	for _, line := range []string{
+		filepath.Join(dir, "bom.txt") + ": has a byte order mark, crlf line endings, want lf",
+		filepath.Join(dir, "crlf.txt") + ": crlf line endings, want lf",
+		"checked 5 files, 3 do not conform",
+	} {
+		if !strings.Contains(string(out), line+"\n") {
+			t.Errorf("check output misses %q:\n%s", line, out)
+		}
+	}
+	if !strings.Contains(string(out), filepath.Join(dir, "gbk.txt")+": encoding gb") {
+		t.Errorf("check output misses gbk.txt:\n%s", out)
+	}
+
+	out, err = transcode(t, nil, "check", "--format", "json", "--bom", "any", filepath.Join(dir, "ascii.txt"), filepath.Join(dir, "bom.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var summary struct {
+		Require string
+		Checked int
+		Failed  int
+		Files   []checkReport
+	}
+	if err = json.Unmarshal(out, &summary); err != nil {
+		t.Fatal(err)
+	}
+	if summary.Require != "utf-8" || summary.Checked != 2 || summary.Failed != 0 || len(summary.Files) != 2 {
+		t.Fatalf("check json = %s", out)
+	}
+	if f := summary.Files[1]; f.Encoding != "utf-8" || !f.BOM || f.EOL != "crlf" {
+		t.Errorf("check json of bom.txt = %+v", f)
+	}
+
+	if _, err = transcode(t, nil, "check", "--require", "gbk", "--bom", "any", filepath.Join(dir, "ascii.txt"), gbk); err != nil {
+		t.Errorf("check --require gbk: %s", err)
+	}
+
+	latin1 := filepath.Join(dir, "latin1.txt")
+	if err := os.WriteFile(latin1, encodeString(t, charmap.ISO8859_1, "Le café était très animé.\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out, err = transcode(t, nil, "check", "--require", "ascii", filepath.Join(dir, "ascii.txt"), latin1)
+	if err == nil || !strings.Contains(string(out), latin1+": encoding ") || !strings.HasSuffix(string(out), "want ascii\nchecked 2 files, 1 do not conform\n") {
+		t.Errorf("check --require ascii = %q, %v", out, err)
+	}
+}
+
+func TestCheckStaged(t *testing.T) {
+	quietLog(t)
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+	dir := writeFiles(t, "a.txt", "hello\n", "b.txt", "\xef\xbb\xbfhi\n")
+	t.Chdir(dir)
+	for _, args := range [][]string{{"init", "-q", "."}, {"add", "a.txt", "b.txt"}} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %s", args, out)
+		}
+	}
+	// the staged content is checked, not the work tree
+	if err := os.WriteFile("a.txt", []byte("\xef\xbb\xbfhello\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile("c.txt", []byte("\xef\xbb\xbfunstaged\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := transcode(t, nil, "check")
+	if err == nil || string(out) != "b.txt: has a byte order mark\nchecked 2 files, 1 do not conform\n" {
+		t.Errorf("check = %q, %v", out, err)
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+//go:generate go run hanzi_gen.go
+
+import (
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+)
+
+// chineseScripts map characters between simplified and traditional Chinese.
+// The maps are per character, so a simplified character with several
+// traditional forms, like 发 for 發 and 髮, always takes the most common one.
+var chineseScripts = map[string]map[rune]rune{
+	"s2t": toTraditional,
+	"t2s": toSimplified,
+}
+
+// chineseTransformer returns the transformer of a --chinese conversion, nil
+// for none.
+func chineseTransformer(conv string) transform.Transformer {
+	m, ok := chineseScripts[conv]
+	if !ok {
+		return nil
+	}
+	return runes.Map(func(r rune) rune {
+		if v, ok := m[r]; ok {
+			return v
+		}
+		return r
+	})
+}
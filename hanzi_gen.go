@@ -0,0 +1,103 @@
+//go:build ignore
+
+// This program generates hanzi_table.go, the character maps between
+// simplified and traditional Chinese, with the Hans-Hant and Hant-Hans
+// transliterators of ICU's uconv. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"maps"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// hanzi returns the Han characters of a double-byte encoding in the given
+// lead byte range.
+func hanzi(enc encoding.Encoding, lead0, lead1, trail0, trail1 byte) (list []rune) {
+	dec := enc.NewDecoder()
+	for l := int(lead0); l <= int(lead1); l++ {
+		for t := int(trail0); t <= int(trail1); t++ {
+			s, err := dec.String(string([]byte{byte(l), byte(t)}))
+			r, n := utf8.DecodeRuneInString(s)
+			if err == nil && n == len(s) && r >= 0x3400 && r != utf8.RuneError {
+				list = append(list, r)
+			}
+		}
+	}
+	return
+}
+
+// transliterate maps every character on its own, as characters in context
+// may convert differently.
+func transliterate(id string, chars []rune) map[rune]rune {
+	var in strings.Builder
+	for _, r := range chars {
+		in.WriteRune(r)
+		in.WriteByte('\n')
+	}
+	cmd := exec.Command("uconv", "-x", id)
+	cmd.Stdin = strings.NewReader(in.String())
+	out, err := cmd.Output()
+	if err != nil {
+		log.Fatalf("uconv -x %s: %s", id, err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")
+	if len(lines) != len(chars) {
+		log.Fatalf("uconv -x %s: got %d lines for %d characters", id, len(lines), len(chars))
+	}
+	m := make(map[rune]rune)
+	for i, l := range lines {
+		r, n := utf8.DecodeRuneInString(l)
+		if n == len(l) && r != chars[i] {
+			m[chars[i]] = r
+		}
+	}
+	return m
+}
+
+func writeMap(buf *bytes.Buffer, name, doc string, m map[rune]rune) {
+	keys := slices.Sorted(maps.Keys(m))
+	fmt.Fprintf(buf, "// %s\nvar %s = map[rune]rune{\n", doc, name)
+	for i, k := range keys {
+		fmt.Fprintf(buf, "'%c': '%c',", k, m[k])
+		if i%8 == 7 || i == len(keys)-1 {
+			buf.WriteByte('\n')
+		} else {
+			buf.WriteByte(' ')
+		}
+	}
+	fmt.Fprintln(buf, "}")
+}
+
+func main() {
+	simplified := hanzi(simplifiedchinese.GBK, 0xb0, 0xf7, 0xa1, 0xfe) // GB2312 Hanzi rows
+	traditional := hanzi(traditionalchinese.Big5, 0xa4, 0xf9, 0x40, 0xfe)
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by hanzi_gen.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package main")
+	fmt.Fprintln(&buf)
+	writeMap(&buf, "toTraditional", "toTraditional maps GB2312 simplified characters to traditional ones.", transliterate("Hans-Hant", simplified))
+	fmt.Fprintln(&buf)
+	writeMap(&buf, "toSimplified", "toSimplified maps Big5 traditional characters to simplified ones.", transliterate("Hant-Hans", traditional))
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile("hanzi_table.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
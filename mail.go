@@ -0,0 +1,325 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/quotedprintable"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+
+	"github.com/gonejack/transcode/chardet"
+)
+
+var encodedWord = regexp.MustCompile(`=\?[^?\s]+\?[bBqQ]\?[^?\s]*\?=`)
+
+var wordDecoder = &mime.WordDecoder{
+	CharsetReader: func(charset string, r io.Reader) (io.Reader, error) {
+		enc, err := parseEncoding(charset)
+		if err != nil {
+			return nil, err
+		}
+		return transform.NewReader(r, enc.NewDecoder()), nil
+	},
+}
+
+// convertMail converts an RFC 5322 message, or every message of an mbox file,
+// to UTF-8. Text parts are decoded by their declared or detected charset and
+// their Content-Type rewritten, encoded-word headers are encoded again as
+// UTF-8, and attachments are copied as they are.
+func (c *trans) convertMail(w io.Writer, r io.Reader, f string) error {
+	dat, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(dat, []byte("From ")) {
+		_, err = w.Write(c.mailPart(dat, f))
+		return err
+	}
+	// mbox: messages start with a From line after an empty line
+	var n int
+	for len(dat) > 0 {
+		end := len(dat)
+		for _, sep := range []string{"\n\nFrom ", "\r\n\r\nFrom "} {
+			if i := bytes.Index(dat, []byte(sep)); i >= 0 && i+len(sep)-5 < end {
+				end = i + len(sep) - 5
+			}
+		}
+		from, msg, _ := bytes.Cut(dat[:end], []byte("\n"))
+		n++
+		if _, err = w.Write(dat[:len(from)+1]); err != nil {
+			return err
+		}
+		if _, err = w.Write(c.mailPart(msg, fmt.Sprintf("%s message %d", f, n))); err != nil {
+			return err
+		}
+		dat = dat[end:]
+	}
+	return nil
+}
+
+// mailPart converts a message or body part, keeping it as it is on errors.
+func (c *trans) mailPart(dat []byte, name string) []byte {
+	eol := "\n"
+	if i := bytes.IndexByte(dat, '\n'); i > 0 && dat[i-1] == '\r' {
+		eol = "\r\n"
+	}
+	var header, sep, body []byte
+	if bytes.HasPrefix(dat, []byte(eol)) {
+		sep, body = dat[:len(eol)], dat[len(eol):] // no header fields
+	} else if i := bytes.Index(dat, []byte(eol+eol)); i >= 0 {
+		header, sep, body = dat[:i+len(eol)], []byte(eol), dat[i+2*len(eol):]
+	} else {
+		header = dat
+	}
+	fields := splitFields(header)
+
+	ctype := fieldValue(fields, "Content-Type")
+	media, params, err := mime.ParseMediaType(ctype)
+	if ctype == "" || err != nil {
+		media, params = "text/plain", map[string]string{}
+	}
+	declared := params["charset"]
+	disposition, _, _ := mime.ParseMediaType(fieldValue(fields, "Content-Disposition"))
+	switch {
+	case disposition == "attachment":
+	case strings.HasPrefix(media, "multipart/") && params["boundary"] != "":
+		body = c.mailMultipart(body, params["boundary"], name)
+	case media == "message/rfc822":
+		body = c.mailPart(body, name)
+	case strings.HasPrefix(media, "text/"):
+		cte := strings.ToLower(fieldValue(fields, "Content-Transfer-Encoding"))
+		text, charset, newCTE, err := c.mailText(body, declared, cte, eol)
+		params["charset"] = "utf-8"
+		newType := mime.FormatMediaType(media, params)
+		switch {
+		case err != nil:
+			log.Printf("kept %s part of %s unchanged: %s", media, name, err)
+		case text != nil && newType == "":
+			log.Printf("kept %s part of %s unchanged: cannot write its Content-Type %s", media, name, ctype)
+		case text != nil:
+			log.Printf("converted %s part of %s from %s to utf-8", media, name, charset)
+			body = text
+			fields = setField(fields, "Content-Type", newType, eol)
+			if newCTE != cte {
+				fields = setField(fields, "Content-Transfer-Encoding", newCTE, eol)
+			}
+		}
+	}
+	for i, fl := range fields {
+		fields[i] = c.mailHeader(fl, declared, name)
+	}
+	return append(append([]byte(strings.Join(fields, "")), sep...), body...)
+}
+
+// mailMultipart converts the parts between the boundary delimiters, the
+// preamble and epilogue are kept.
+func (c *trans) mailMultipart(body []byte, boundary, name string) []byte {
+	delim, closing := []byte("--"+boundary), []byte("--"+boundary+"--")
+	var out []byte
+	var part []byte
+	inPart := false
+	for len(body) > 0 {
+		line := body
+		if i := bytes.IndexByte(body, '\n'); i >= 0 {
+			line = body[:i+1]
+		}
+		body = body[len(line):]
+		trimmed := bytes.TrimRight(line, " \t\r\n")
+		isDelim := bytes.Equal(trimmed, delim)
+		isClose := bytes.Equal(trimmed, closing)
+		if !isDelim && !isClose {
+			if inPart {
+				part = append(part, line...)
+			} else {
+				out = append(out, line...)
+			}
+			continue
+		}
+		if inPart {
+			// the line break before a delimiter belongs to the delimiter
+			content, eol := part, []byte(nil)
+			if bytes.HasSuffix(content, []byte("\r\n")) {
+				content, eol = content[:len(content)-2], []byte("\r\n")
+			} else if bytes.HasSuffix(content, []byte("\n")) {
+				content, eol = content[:len(content)-1], []byte("\n")
+			}
+			out = append(append(out, c.mailPart(content, name)...), eol...)
+			part = nil
+		}
+		out = append(out, line...)
+		inPart = isDelim
+	}
+	return append(out, part...)
+}
+
+// mailText decodes a text body by its transfer encoding and charset and
+// encodes it as UTF-8 again. It returns nil when the body is UTF-8 or ASCII
+// already.
+func (c *trans) mailText(body []byte, charset, cte, eol string) (text []byte, source, newCTE string, err error) {
+	var raw []byte
+	switch cte {
+	case "quoted-printable":
+		raw, err = io.ReadAll(quotedprintable.NewReader(bytes.NewReader(body)))
+	case "base64":
+		raw, err = base64.StdEncoding.DecodeString(strings.Map(func(r rune) rune {
+			if strings.ContainsRune(" \t\r\n", r) {
+				return -1
+			}
+			return r
+		}, string(body)))
+	default:
+		raw = body
+	}
+	if err != nil {
+		return nil, "", "", fmt.Errorf("decode %s failed: %w", cte, err)
+	}
+	source = chardet.Canonical(charset)
+	switch {
+	case source == "utf-8", (source == "ascii" || source == "") && isASCII(string(raw)):
+		return nil, "", "", nil
+	}
+	dec, err := c.mailDecode(raw, source)
+	if err != nil {
+		return nil, "", "", err
+	}
+	switch cte {
+	case "quoted-printable":
+		var buf bytes.Buffer
+		qw := quotedprintable.NewWriter(&buf)
+		qw.Write(dec.text)
+		qw.Close()
+		text = buf.Bytes()
+		if eol == "\n" {
+			text = bytes.ReplaceAll(text, []byte("\r\n"), []byte("\n"))
+		}
+		return text, dec.charset, cte, nil
+	case "base64":
+		enc := base64.StdEncoding.EncodeToString(dec.text)
+		var buf bytes.Buffer
+		for len(enc) > 76 {
+			buf.WriteString(enc[:76] + eol)
+			enc = enc[76:]
+		}
+		buf.WriteString(enc)
+		if bytes.HasSuffix(body, []byte(eol)) {
+			buf.WriteString(eol)
+		}
+		return buf.Bytes(), dec.charset, cte, nil
+	}
+	newCTE = cte
+	if !isASCII(string(dec.text)) && (cte == "" || cte == "7bit") {
+		newCTE = "8bit"
+	}
+	return dec.text, dec.charset, newCTE, nil
+}
+
+type mailDecoded struct {
+	text    []byte
+	charset string
+}
+
+// mailDecode decodes raw by the declared charset, or the detected one when
+// there is none or, with --trust-declared other than always, it does not
+// decode cleanly. Without a declared charset the one of -s is used when given.
+func (c *trans) mailDecode(raw []byte, charset string) (mailDecoded, error) {
+	if charset == "" && c.source != nil {
+		text, err := c.source.NewDecoder().Bytes(raw)
+		if err != nil {
+			return mailDecoded{}, fmt.Errorf("decode as %s failed: %w", c.source, err)
+		}
+		return mailDecoded{text, chardet.Canonical(c.SourceEncoding)}, nil
+	}
+	if charset != "" && c.TrustDeclared != "never" {
+		enc, err := parseEncoding(charset)
+		if err == nil {
+			text, exx := enc.NewDecoder().Bytes(raw)
+			clean := exx == nil && !bytes.ContainsRune(text, utf8.RuneError)
+			if clean || (exx == nil && c.TrustDeclared == "always") {
+				return mailDecoded{text, charset}, nil
+			}
+		}
+	}
+	coding, err := chardet.DetectEncoding(raw[:min(len(raw), 2048)], c.detectOptions()...)
+	if err != nil {
+		return mailDecoded{}, fmt.Errorf("cannot determine charset: %w", err)
+	}
+	enc, err := parseEncoding(coding)
+	if err != nil {
+		return mailDecoded{}, err
+	}
+	text, err := enc.NewDecoder().Bytes(raw)
+	if err != nil {
+		return mailDecoded{}, err
+	}
+	return mailDecoded{text, coding}, nil
+}
+
+// mailHeader encodes the encoded words of a header field in UTF-8 again, and
+// decodes raw 8-bit bytes, which legacy mailers wrote in the charset of the
+// body, to UTF-8.
+func (c *trans) mailHeader(field, charset, name string) string {
+	if !isASCII(field) && !utf8.ValidString(field) {
+		if dec, err := c.mailDecode([]byte(field), charset); err == nil {
+			field = string(dec.text)
+		} else {
+			log.Printf("kept header of %s unchanged: %s", name, err)
+		}
+	}
+	return encodedWord.ReplaceAllStringFunc(field, func(word string) string {
+		charset, _, _ := strings.Cut(word[2:], "?")
+		if chardet.Canonical(charset) == "utf-8" {
+			return word
+		}
+		text, err := wordDecoder.Decode(word)
+		if err != nil {
+			log.Printf("kept encoded word %s of %s: %s", word, name, err)
+			return word
+		}
+		return mime.BEncoding.Encode("utf-8", text)
+	})
+}
+
+// splitFields splits a header into fields, each with its continuation lines
+// and line break.
+func splitFields(header []byte) (fields []string) {
+	for _, line := range strings.SplitAfter(string(header), "\n") {
+		switch {
+		case line == "":
+		case (line[0] == ' ' || line[0] == '\t') && len(fields) > 0:
+			fields[len(fields)-1] += line
+		default:
+			fields = append(fields, line)
+		}
+	}
+	return
+}
+
+// fieldValue returns the unfolded value of the first field named name.
+func fieldValue(fields []string, name string) string {
+	for _, f := range fields {
+		k, v, ok := strings.Cut(f, ":")
+		if ok && strings.EqualFold(strings.TrimSpace(k), name) {
+			return strings.Join(strings.Fields(v), " ")
+		}
+	}
+	return ""
+}
+
+// setField replaces the first field named name, or adds it.
+func setField(fields []string, name, value, eol string) []string {
+	for i, f := range fields {
+		k, _, ok := strings.Cut(f, ":")
+		if ok && strings.EqualFold(strings.TrimSpace(k), name) {
+			fields[i] = k + ": " + value + eol
+			return fields
+		}
+	}
+	return append(fields, name+": "+value+eol)
+}
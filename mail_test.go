@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+func qp(t *testing.T, dat []byte) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w := quotedprintable.NewWriter(&buf)
+	w.Write(dat)
+	w.Close()
+	return buf.String()
+}
+
+func TestMail(t *testing.T) {
+	quietLog(t)
+	zh := "早班火车又晚点了。站台上没有人感到意外。"
+	ru := "Утренний поезд снова опоздал."
+	attachment := encodeString(t, simplifiedchinese.GBK, zh)
+	binary := base64.StdEncoding.EncodeToString(binaryMember)
+	jis := encodeString(t, japanese.ISO2022JP, "会議の議事録")
+	subject := "=?ISO-2022-JP?B?" + base64.StdEncoding.EncodeToString(jis) + "?="
+	from := "=?koi8-r?Q?" + strings.ReplaceAll(qp(t, encodeString(t, charmap.KOI8R, "Иван")), "=\r\n", "") + "?= <ivan@example.com>"
+
+	msg := strings.Join([]string{
+		"From: " + from,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/mixed; boundary=\"b1\"",
+		"",
+		"This is a multi-part message in MIME format.",
+		"--b1",
+		"Content-Type: text/plain; charset=gb2312",
+		"Content-Transfer-Encoding: base64",
+		"",
+		base64.StdEncoding.EncodeToString(encodeString(t, simplifiedchinese.GBK, zh)),
+		"--b1",
+		"Content-Type: text/html; charset=\"koi8-r\"",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		qp(t, encodeString(t, charmap.KOI8R, "<p>"+ru+"</p>")),
+		"--b1",
+		"Content-Type: text/plain; charset=gbk; name=\"notes.txt\"",
+		"Content-Disposition: attachment; filename=\"notes.txt\"",
+		"",
+		string(attachment),
+		"--b1",
+		"Content-Type: image/png",
+		"Content-Transfer-Encoding: base64",
+		"",
+		binary,
+		"--b1--",
+		"",
+	}, "\r\n")
+
+	f := filepath.Join(t.TempDir(), "message.eml")
+	if err := os.WriteFile(f, []byte(msg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := transcode(t, nil, "--mail", f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := mail.ReadMessage(bytes.NewReader(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range []struct{ name, want string }{{"Subject", "会議の議事録"}, {"From", "Иван <ivan@example.com>"}} {
+		got, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get(h.name))
+		if err != nil || got != h.want || !strings.Contains(m.Header.Get(h.name), "=?utf-8?") {
+			t.Errorf("%s: got %q decoded to %q, want %q", h.name, m.Header.Get(h.name), got, h.want)
+		}
+	}
+	_, params, _ := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	mr := multipart.NewReader(m.Body, params["boundary"])
+	want := []struct {
+		ctype string
+		body  string
+	}{
+		{"text/plain; charset=utf-8", zh},
+		{"text/html; charset=utf-8", "<p>" + ru + "</p>"},
+		{"text/plain; charset=gbk; name=\"notes.txt\"", string(attachment)},
+		{"image/png", string(binaryMember)},
+	}
+	for _, w := range want {
+		p, err := mr.NextRawPart()
+		if err != nil {
+			t.Fatal(err)
+		}
+		raw, _ := io.ReadAll(p)
+		body := raw
+		switch p.Header.Get("Content-Transfer-Encoding") {
+		case "base64":
+			body, _ = base64.StdEncoding.DecodeString(strings.ReplaceAll(string(raw), "\r\n", ""))
+		case "quoted-printable":
+			body, _ = io.ReadAll(quotedprintable.NewReader(bytes.NewReader(raw)))
+		}
+		if got := p.Header.Get("Content-Type"); got != w.ctype {
+			t.Errorf("got part %s, want %s", got, w.ctype)
+		} else if string(body) != w.body {
+			t.Errorf("%s: got %q, want %q", w.ctype, body, w.body)
+		}
+	}
+	if !bytes.HasPrefix(out, []byte("From: ")) || !bytes.Contains(out, []byte("\r\n--b1--\r\n")) {
+		t.Errorf("structure changed: %q", out)
+	}
+	if !bytes.Contains(out, []byte(binary)) {
+		t.Error("attachment re-encoded")
+	}
+}
+
+func TestMbox(t *testing.T) {
+	quietLog(t)
+	jis := encodeString(t, japanese.ISO2022JP, "こんにちは")
+	mbox := "From alice@example.com Mon Jan  1 00:00:00 2001\n" +
+		"Subject: first\nContent-Type: text/plain; charset=iso-2022-jp\nContent-Transfer-Encoding: 7bit\n\n" + string(jis) + "\n\n" +
+		"From bob@example.com Mon Jan  1 00:00:00 2001\n" +
+		"Subject: second\n\nplain ascii\n>From quoted\n\n" +
+		"From carol@example.com Mon Jan  1 00:00:00 2001\n" +
+		"Subject: third\nContent-Type: text/plain; charset=us-ascii\n\nkept as us-ascii\n"
+	want := "From alice@example.com Mon Jan  1 00:00:00 2001\n" +
+		"Subject: first\nContent-Type: text/plain; charset=utf-8\nContent-Transfer-Encoding: 8bit\n\nこんにちは\n\n" +
+		"From bob@example.com Mon Jan  1 00:00:00 2001\n" +
+		"Subject: second\n\nplain ascii\n>From quoted\n\n" +
+		"From carol@example.com Mon Jan  1 00:00:00 2001\n" +
+		"Subject: third\nContent-Type: text/plain; charset=us-ascii\n\nkept as us-ascii\n"
+	out, err := transcode(t, []byte(mbox), "--mail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != want {
+		t.Errorf("got %q, want %q", out, want)
+	}
+	if _, err := transcode(t, []byte(mbox), "--mail", "-t", "gbk"); err == nil || !strings.Contains(err.Error(), "utf-8 only") {
+		t.Errorf("got %v converting mail to gbk", err)
+	}
+}
+
+func TestMailSourceEncoding(t *testing.T) {
+	quietLog(t)
+	cp1251 := encodeString(t, charmap.Windows1251, "Ещё")
+	jis := encodeString(t, japanese.ISO2022JP, "こんにちは")
+	mbox := "From alice@example.com Mon Jan  1 00:00:00 2001\n" +
+		"Subject: " + string(cp1251) + "\n\n" + string(cp1251) + "\n\n" +
+		"From bob@example.com Mon Jan  1 00:00:00 2001\n" +
+		"Subject: declared\nContent-Type: text/plain; charset=iso-2022-jp\n\n" + string(jis) + "\n"
+	want := "From alice@example.com Mon Jan  1 00:00:00 2001\n" +
+		"Subject: Ещё\nContent-Type: text/plain; charset=utf-8\nContent-Transfer-Encoding: 8bit\n\nЕщё\n\n" +
+		"From bob@example.com Mon Jan  1 00:00:00 2001\n" +
+		"Subject: declared\nContent-Type: text/plain; charset=utf-8\nContent-Transfer-Encoding: 8bit\n\nこんにちは\n"
+	out, err := transcode(t, []byte(mbox), "--mail", "-s", "windows-1251")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != want {
+		t.Errorf("got %q, want %q", out, want)
+	}
+}
@@ -1,11 +1,53 @@
 package main
 
 import (
+	"fmt"
 	"log"
+	"os"
+
+	"github.com/alecthomas/kong"
 )
 
+type cli struct {
+	CharmapFile []string `name:"charmap-file" type:"existingfile" help:"Load a custom single-byte code page from a mapping file (.txt or .ucm), can be repeated."`
+	About       bool     `help:"Show about."`
+
+	Convert     trans       `cmd:"" default:"withargs" help:"Translate text encoding (default)."`
+	Fix         fixer       `cmd:"" help:"Repair mojibake, text decoded with a wrong encoding and saved again."`
+	BenchDetect benchDetect `cmd:"" name:"bench-detect" help:"Measure accuracy and latency of the detection backends on a test corpus."`
+	Serve       server      `cmd:"" help:"Serve detection and conversion over HTTP."`
+	Daemon      daemon      `cmd:"" help:"Answer detection and conversion requests of a co-process."`
+	Watch       watcher     `cmd:"" help:"Convert files as they land in a directory."`
+	Names       renamer     `cmd:"" help:"Rename files with names in legacy encodings to UTF-8."`
+	GitTextconv textconv    `cmd:"" name:"git-textconv" help:"Show a file as UTF-8 for git diff, as a textconv driver."`
+	Check       checker     `cmd:"" help:"Check files, by default the ones staged in git, have the required encoding, BOM and line endings."`
+}
+
+func (c *cli) parser() *kong.Kong {
+	return kong.Must(c,
+		kong.Name("transcode"),
+		kong.Description("Translate text encoding."),
+		kong.UsageOnError(),
+	)
+}
+
+func (c *cli) run(ctx *kong.Context) error {
+	if c.About {
+		fmt.Println("Visit https://github.com/gonejack/transcode")
+		return nil
+	}
+	if err := loadCharmaps(c.CharmapFile); err != nil {
+		return err
+	}
+	return ctx.Run()
+}
+
 func main() {
-	if e := new(trans).run(); e != nil {
+	c := new(cli)
+	parser := c.parser()
+	ctx, err := parser.Parse(os.Args[1:])
+	parser.FatalIfErrorf(err)
+	if e := c.run(ctx); e != nil {
 		log.Fatal(e)
 	}
 }
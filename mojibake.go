@@ -0,0 +1,470 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+
+	"github.com/gonejack/transcode/chardet"
+)
+
+// fixer repairs mojibake: text encoded with one encoding, decoded with another
+// and saved again, such as "Ã©" for "é" or "鍙傛暟" for "参数".
+type fixer struct {
+	SourceEncoding string   `short:"s" name:"source-encoding" default:"auto" help:"Set source encoding, default as auto-detection."`
+	Overwrite      bool     `short:"w" name:"overwrite" help:"Apply the repair to source file."`
+	Preview        bool     `short:"p" name:"preview" help:"Only report the repair and sample lines, do not output text."`
+	File           []string `arg:"" optional:""`
+	detection
+}
+
+const (
+	maxRepairs      = 3    // double or triple encoded text takes several rounds
+	minRepairScore  = 0.3  // below this a repair is not trusted
+	repairSampleLen = 8192 // bytes of non-ASCII lines scored per candidate
+	repairVerified  = 8    // best candidates double checked with the detectors
+)
+
+func (c *fixer) Run() (err error) {
+	if err = c.detection.check(); err != nil {
+		return
+	}
+	if len(c.File) == 0 {
+		c.File = append(c.File, "-")
+	}
+	for _, f := range c.File {
+		err = c.proc(f)
+		if err != nil {
+			return fmt.Errorf("process %s failed: %w", f, err)
+		}
+	}
+	return
+}
+func (c *fixer) proc(f string) (err error) {
+	src, out := os.Stdin, io.Writer(os.Stdout)
+	if f != "-" {
+		src, err = os.Open(f)
+		if err != nil {
+			return
+		}
+		defer src.Close()
+		st, exx := src.Stat()
+		switch {
+		case exx != nil:
+			return fmt.Errorf("read file info failed: %w", exx)
+		case !st.Mode().IsRegular():
+			return errors.New("not a regular file")
+		case st.Size() == 0:
+			log.Printf("no changes, source file %s is empty", f)
+			return
+		}
+	}
+	dat, err := io.ReadAll(src)
+	if err != nil {
+		return
+	}
+	var source encoding.Encoding
+	if strings.EqualFold(c.SourceEncoding, "auto") {
+		source, err = autoEncoding(bufio.NewReader(bytes.NewReader(dat)), c.detectOptions()...)
+		if err != nil {
+			return fmt.Errorf("cannot determine source-encoding: %w", err)
+		}
+	} else {
+		source, err = parseEncoding(c.SourceEncoding)
+		if err != nil {
+			return fmt.Errorf("parse source-encoding %s failed: %w", c.SourceEncoding, err)
+		}
+	}
+	text, err := source.NewDecoder().Bytes(dat)
+	if err != nil {
+		return fmt.Errorf("decode as %s failed: %w", source, err)
+	}
+	lines := strings.SplitAfter(string(text), "\n")
+	chain := findRepairs(lines)
+	if len(chain) == 0 {
+		log.Printf("no changes, no mojibake found in file %s", f)
+		if c.Preview || c.Overwrite {
+			return
+		}
+		_, err = os.Stdout.Write(dat)
+		return
+	}
+	fixed := slices.Clone(lines)
+	for _, r := range chain {
+		r.apply(fixed)
+	}
+	c.report(f, chain, lines, fixed)
+	if c.Preview {
+		return
+	}
+	repaired, err := source.NewEncoder().Bytes([]byte(strings.Join(fixed, "")))
+	if err != nil {
+		return fmt.Errorf("encode repaired text as %s failed: %w", source, err)
+	}
+	if src != os.Stdin && c.Overwrite {
+		return replaceFile(f, repaired)
+	}
+	_, err = out.Write(repaired)
+	return
+}
+
+// replaceFile writes dat next to f first and renames it over f, so f is never
+// left half written.
+func replaceFile(f string, dat []byte) (err error) {
+	st, err := os.Stat(f)
+	if err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f), ".transcode.*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(dat)
+	if err == nil {
+		err = tmp.Chmod(st.Mode().Perm())
+	}
+	if exx := tmp.Close(); err == nil {
+		err = exx
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return
+}
+
+func (c *fixer) report(f string, chain []*repair, before, after []string) {
+	names := make([]string, len(chain))
+	for i, r := range chain {
+		names[i] = r.String()
+	}
+	changed := 0
+	var sample []string
+	for i := range before {
+		if before[i] == after[i] {
+			continue
+		}
+		changed++
+		if len(sample) < 6 {
+			sample = append(sample, "- "+strings.TrimRight(before[i], "\r\n"), "+ "+strings.TrimRight(after[i], "\r\n"))
+		}
+	}
+	log.Printf("repaired %d of %d lines of file %s, %s:\n%s", changed, len(before), f,
+		strings.Join(names, ", then "), strings.Join(sample, "\n"))
+}
+
+// repair undoes one round of mojibake: the text is encoded back to the bytes
+// it was wrongly decoded from and decoded again with the original encoding.
+type repair struct {
+	wrong, orig       *encodingInfo
+	wrongEnc, origEnc encoding.Encoding
+	score             float64
+	evidence          bool // mojibake patterns went away
+}
+
+func (r *repair) String() string {
+	return fmt.Sprintf("%s text read as %s", r.orig.Name, r.wrong.Name)
+}
+
+// line repairs a single line, lines that do not survive the round trip
+// unchanged are left alone, so correct text mixed into a file is kept.
+func (r *repair) line(s string) (string, bool) {
+	b, ok := r.encode(s)
+	if !ok {
+		return s, false
+	}
+	return r.decode(s, b)
+}
+func (r *repair) encode(s string) ([]byte, bool) {
+	if isASCII(s) {
+		return nil, false
+	}
+	b, err := r.wrongEnc.NewEncoder().Bytes([]byte(s))
+	if err != nil && r.wrong.MaxBytes == 1 {
+		b, err = encodeC1(r.wrongEnc, s)
+	}
+	return b, err == nil
+}
+
+// encodeC1 encodes s keeping C1 controls as the bytes they stand for, which
+// is what decoders do with bytes a single-byte code page leaves undefined.
+func encodeC1(enc encoding.Encoding, s string) (b []byte, err error) {
+	start := 0
+	for i, c := range s + "\x00" {
+		if i < len(s) && (c < 0x80 || c > 0x9f) {
+			continue
+		}
+		seg, err := enc.NewEncoder().String(s[start:i])
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, seg...)
+		if i < len(s) {
+			b = append(b, byte(c))
+		}
+		start = i + utf8.RuneLen(c)
+	}
+	return
+}
+func (r *repair) decode(s string, b []byte) (string, bool) {
+	t, err := r.origEnc.NewDecoder().Bytes(b)
+	if err != nil || string(t) == s || bytes.Count(t, []byte("\uFFFD")) > strings.Count(s, "\uFFFD") {
+		return s, false
+	}
+	return string(t), true
+}
+func (r *repair) apply(lines []string) {
+	for i, s := range lines {
+		lines[i], _ = r.line(s)
+	}
+}
+
+// findRepairs searches encoding pairs for the chain that turns the text into
+// the most plausible one, each round is applied before looking for the next.
+func findRepairs(lines []string) (chain []*repair) {
+	lines = slices.Clone(lines)
+	for range maxRepairs {
+		r := bestRepair(repairSample(lines))
+		if r == nil {
+			break
+		}
+		r.apply(lines)
+		chain = append(chain, r)
+	}
+	return
+}
+
+func repairSample(lines []string) (sample []string) {
+	n := 0
+	for _, s := range lines {
+		if n >= repairSampleLen {
+			break
+		}
+		if !isASCII(s) {
+			sample = append(sample, s)
+			n += len(s)
+		}
+	}
+	return
+}
+
+func bestRepair(sample []string) *repair {
+	if len(sample) == 0 {
+		return nil
+	}
+	var wrong, orig []*repair
+	for _, name := range encodings() {
+		info, _ := lookupEncoding(name)
+		if !info.ASCII || strings.HasSuffix(info.Name, "-bom") {
+			continue
+		}
+		enc, err := parseEncoding(info.Name)
+		if err != nil {
+			continue
+		}
+		orig = append(orig, &repair{orig: info, origEnc: enc})
+		if info.Group != "Unicode" {
+			wrong = append(wrong, &repair{wrong: info, wrongEnc: enc})
+		}
+	}
+	var found []*repair
+	encoded := make([][]byte, len(sample))
+	for _, w := range wrong {
+		usable := false
+		for i, s := range sample {
+			var ok bool
+			encoded[i], ok = w.encode(s)
+			if !ok {
+				encoded[i] = nil
+			}
+			usable = usable || ok
+		}
+		if !usable {
+			continue
+		}
+		for _, o := range orig {
+			if o.origEnc == w.wrongEnc {
+				continue
+			}
+			r := &repair{wrong: w.wrong, wrongEnc: w.wrongEnc, orig: o.orig, origEnc: o.origEnc}
+			if r.score = r.evaluate(sample, encoded); r.score > 0 {
+				found = append(found, r)
+			}
+		}
+	}
+	slices.SortStableFunc(found, func(a, b *repair) int {
+		switch {
+		case a.score > b.score:
+			return -1
+		case a.score < b.score:
+			return 1
+		}
+		return 0
+	})
+	var best *repair
+	for _, r := range found[:min(len(found), repairVerified)] {
+		if r.agrees(sample) {
+			r.score += 0.5
+		} else if !r.evidence {
+			continue
+		}
+		if best == nil || r.score > best.score {
+			best = r
+		}
+	}
+	if best == nil || best.score < minRepairScore {
+		return nil
+	}
+	return best
+}
+
+// evaluate scores a candidate on the lines it changes: mojibake never spends
+// fewer characters than the text it came from, and leaves telltale pairs,
+// control characters, script changes and accent-laden words behind.
+func (r *repair) evaluate(sample []string, encoded [][]byte) float64 {
+	var changed, before, after, fixed int
+	for i, s := range sample {
+		if encoded[i] == nil {
+			continue
+		}
+		t, ok := r.decode(s, encoded[i])
+		if !ok {
+			continue
+		}
+		changed++
+		before += nonASCII(s)
+		after += nonASCII(t)
+		fixed += mojibakeMarkers(s) - mojibakeMarkers(t)
+		fixed += unusualRunes(s) - unusualRunes(t)
+		fixed += scriptChanges(s) - scriptChanges(t)
+		fixed += accentedWords(s) - accentedWords(t)
+	}
+	if changed == 0 || after > before {
+		return 0
+	}
+	r.evidence = fixed > 0
+	score := float64(before-after)/float64(before) + float64(fixed)/float64(before)
+	return score * (0.5 + 0.5*float64(changed)/float64(len(sample)))
+}
+
+// agrees reports whether the detectors take the recovered bytes for the
+// original encoding of the candidate.
+func (r *repair) agrees(sample []string) bool {
+	var buf bytes.Buffer
+	for _, s := range sample {
+		if b, ok := r.encode(s); ok {
+			if _, ok = r.decode(s, b); ok {
+				buf.Write(b)
+			}
+		}
+	}
+	name, err := chardet.DetectEncoding(buf.Bytes(), chardet.TrustDeclared(chardet.TrustNever))
+	if err != nil {
+		return false
+	}
+	enc, err := parseEncoding(name)
+	return err == nil && enc == r.origEnc
+}
+
+// cp1252High holds what windows-1252 makes of UTF-8 continuation bytes.
+const cp1252High = "€‚ƒ„…†‡ˆ‰Š‹ŒŽ‘’“”•–—˜™š›œžŸ"
+
+// mojibakeMarkers counts UTF-8 lead bytes read as Latin-1 or windows-1252
+// followed by a continuation byte read the same way, like "Ã©".
+func mojibakeMarkers(s string) (n int) {
+	var prev rune
+	for _, r := range s {
+		if prev >= 0xC2 && prev <= 0xF4 && (r >= 0x80 && r <= 0xBF || strings.ContainsRune(cp1252High, r)) {
+			n++
+		}
+		prev = r
+	}
+	return
+}
+func unusualRunes(s string) (n int) {
+	for _, r := range s {
+		if r == utf8.RuneError || unicode.Is(unicode.Co, r) || !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			n++
+		}
+	}
+	return
+}
+
+var scripts = []*unicode.RangeTable{
+	unicode.Latin, unicode.Greek, unicode.Cyrillic, unicode.Armenian, unicode.Hebrew, unicode.Arabic,
+	unicode.Thai, unicode.Hangul, unicode.Han, unicode.Hiragana, unicode.Katakana,
+}
+
+// scriptChanges counts letters of a word written in another script than the
+// letter before, Han and kana count as one script.
+func scriptChanges(s string) (n int) {
+	prev := -1
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			prev = -1
+			continue
+		}
+		cur := len(scripts)
+		for i, t := range scripts {
+			if unicode.Is(t, r) {
+				cur = min(i, 8)
+				break
+			}
+		}
+		if prev >= 0 && cur != prev {
+			n++
+		}
+		prev = cur
+	}
+	return
+}
+
+// accentedWords counts words of three or more letters written mostly in
+// accented Latin, as Cyrillic or Greek read as windows-1252 look.
+func accentedWords(s string) (n int) {
+	letters, accented := 0, 0
+	for _, r := range s + " " {
+		switch {
+		case unicode.IsLetter(r):
+			letters++
+			if r >= utf8.RuneSelf && unicode.Is(unicode.Latin, r) {
+				accented++
+			}
+		default:
+			if letters >= 3 && accented*2 > letters {
+				n++
+			}
+			letters, accented = 0, 0
+		}
+	}
+	return
+}
+func nonASCII(s string) (n int) {
+	for _, r := range s {
+		if r >= utf8.RuneSelf {
+			n++
+		}
+	}
+	return
+}
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
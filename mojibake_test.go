@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFindRepairs(t *testing.T) {
+	tests := []struct {
+		in    string
+		out   string
+		chain []string
+	}{
+		{"CafÃ© crÃ¨me brÃ»lÃ©e\nplain line\n", "Café crème brûlée\nplain line\n", []string{"utf-8 text read as windows-1252"}},
+		{"CafÃƒÂ© crÃƒÂ¨me\n", "Café crème\n", []string{"utf-8 text read as windows-1252", "utf-8 text read as windows-1252"}},
+		{"Ð”Ð¾Ð±Ñ€Ñ‹Ð¹ Ð´ÐµÐ½ÑŒ\n", "Добрый день\n", []string{"utf-8 text read as windows-1252"}},
+		{"鍙傛暟璁剧疆瀹屾垚\n", "参数设置完成\n", []string{"utf-8 text read as gbk"}},
+		{"Ïðèâåò, êàê äåëà? Âñ¸ õîðîøî.\n", "Привет, как дела? Всё хорошо.\n", []string{"windows-1251 text read as windows-1252"}},
+		{"“ú–{Œê‚ÌƒeƒLƒXƒg‚Å‚·\u0081B\n", "日本語のテキストです。\n", []string{"shift_jis text read as windows-1252"}},
+		{"正常的中文文本，没有乱码。\nCafé crème\n", "正常的中文文本，没有乱码。\nCafé crème\n", nil},
+		{"Ελληνικά και русский текст и français naïve résumé\n", "Ελληνικά και русский текст и français naïve résumé\n", nil},
+		{"only ascii\n", "only ascii\n", nil},
+	}
+	for _, tt := range tests {
+		lines := strings.SplitAfter(tt.in, "\n")
+		chain := findRepairs(lines)
+		var names []string
+		for _, r := range chain {
+			names = append(names, r.String())
+			r.apply(lines)
+		}
+		if out := strings.Join(lines, ""); out != tt.out || strings.Join(names, ";") != strings.Join(tt.chain, ";") {
+			t.Errorf("findRepairs(%q) = %q by %v; want %q by %v", tt.in, out, names, tt.out, tt.chain)
+		}
+	}
+}
+
+func TestFix(t *testing.T) {
+	const mojibake, want = "CafÃ© crÃ¨me brÃ»lÃ©e\nplain line\n", "Café crème brûlée\nplain line\n"
+	dir := t.TempDir()
+	f := filepath.Join(dir, "menu.txt")
+	if err := os.WriteFile(f, []byte(mojibake), 0640); err != nil {
+		t.Fatal(err)
+	}
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	log.SetFlags(0)
+	t.Cleanup(func() { log.SetOutput(os.Stderr); log.SetFlags(log.LstdFlags) })
+
+	for _, flag := range []string{"--preview", "-p"} {
+		logs.Reset()
+		out, err := transcode(t, nil, "fix", flag, f)
+		if err != nil {
+			t.Fatalf("fix %s: %s", flag, err)
+		}
+		if len(out) > 0 {
+			t.Errorf("fix %s wrote %q", flag, out)
+		}
+		report := "repaired 1 of 3 lines of file " + f + ", utf-8 text read as windows-1252:\n- CafÃ© crÃ¨me brÃ»lÃ©e\n+ Café crème brûlée\n"
+		if logs.String() != report {
+			t.Errorf("fix %s log = %q, want %q", flag, logs.String(), report)
+		}
+		if dat, _ := os.ReadFile(f); string(dat) != mojibake {
+			t.Errorf("fix %s changed the file to %q", flag, dat)
+		}
+	}
+
+	out, err := transcode(t, nil, "fix", "-w", f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) > 0 {
+		t.Errorf("fix -w wrote %q", out)
+	}
+	dat, err := os.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(dat) != want {
+		t.Errorf("fix -w repaired file = %q, want %q", dat, want)
+	}
+	if st, err := os.Stat(f); err != nil {
+		t.Error(err)
+	} else if st.Mode().Perm() != 0640 {
+		t.Errorf("fix -w file mode = %v, want %v", st.Mode().Perm(), os.FileMode(0640))
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 1 {
+		t.Errorf("fix -w left files behind: %v", files)
+	}
+}
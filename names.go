@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/gonejack/transcode/chardet"
+)
+
+// renamer converts file names from legacy encodings to UTF-8, like convmv.
+// The encoding is detected over all names of a directory, a single name is
+// too short to tell GBK from Shift-JIS.
+type renamer struct {
+	SourceEncoding string   `short:"s" name:"source-encoding" default:"auto" help:"Set source encoding of names, default as auto-detection per directory."`
+	Rename         bool     `short:"w" name:"rename" help:"Rename the files, default only previews the renames."`
+	Recursive      bool     `short:"r" name:"recursive" help:"Also rename in subdirectories of the directories given."`
+	Normalize      string   `name:"normalize" enum:"none,nfc,nfd" default:"none" help:"Normalize names to Unicode NFC, or NFD as on macOS, UTF-8 names included, one of none,nfc,nfd."`
+	OnCollision    string   `name:"on-collision" enum:"skip,suffix" default:"skip" help:"When the new name exists, skip the file or add a numbered suffix, one of skip,suffix."`
+	Path           []string `arg:"" type:"path" help:"Files to rename, or directories to rename the entries of."`
+	detection
+
+	source encoding.Encoding
+	failed int
+}
+
+func (c *renamer) Run() (err error) {
+	if err = c.detection.check(); err != nil {
+		return
+	}
+	if !strings.EqualFold(c.SourceEncoding, "auto") {
+		if c.source, err = parseEncoding(c.SourceEncoding); err != nil {
+			return fmt.Errorf("parse source-encoding %s failed: %w", c.SourceEncoding, err)
+		}
+	}
+	for _, p := range c.Path {
+		st, err := os.Lstat(p)
+		if err != nil {
+			return err
+		}
+		if st.IsDir() {
+			err = c.renameDir(p)
+		} else {
+			err = c.renameIn(filepath.Dir(p), []string{filepath.Base(p)})
+		}
+		if err != nil {
+			return fmt.Errorf("process %s failed: %w", p, err)
+		}
+	}
+	if c.failed > 0 {
+		return fmt.Errorf("%d renames failed", c.failed)
+	}
+	return
+}
+
+// renameDir renames the entries of dir, those of subdirectories first so the
+// paths stay valid.
+func (c *renamer) renameDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name()
+		if c.Recursive && e.IsDir() {
+			if err = c.renameDir(filepath.Join(dir, e.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return c.renameIn(dir, names)
+}
+
+// renameIn renames the given entries of dir.
+func (c *renamer) renameIn(dir string, names []string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	taken := make(map[string]bool, len(entries))
+	all := make([]string, len(entries))
+	for i, e := range entries {
+		all[i] = e.Name()
+		taken[e.Name()] = true
+	}
+	enc, err := c.nameEncoding(all)
+	if err != nil {
+		log.Printf("skipped names in %s: %s", dir, err)
+	}
+	for _, name := range names {
+		to, ok := c.convertName(name, enc)
+		if !ok || to == name {
+			continue
+		}
+		old := filepath.Join(dir, name)
+		if taken[to] && !sameFile(old, filepath.Join(dir, to)) {
+			if c.OnCollision == "skip" {
+				log.Printf("skipped %q, %s exists in %s", name, to, dir)
+				continue
+			}
+			to = uniqueName(to, taken)
+		}
+		if !c.Rename {
+			log.Printf("would rename %q to %s in %s", name, to, dir)
+		} else if err = os.Rename(old, filepath.Join(dir, to)); err != nil {
+			log.Printf("rename %q to %s in %s failed: %s", name, to, dir, err)
+			c.failed++
+			continue
+		} else {
+			log.Printf("renamed %q to %s in %s", name, to, dir)
+		}
+		delete(taken, name)
+		taken[to] = true
+	}
+	return nil
+}
+
+// nameEncoding returns the encoding of the names that are not UTF-8, nil
+// when there are none. A detected encoding must decode all of them.
+func (c *renamer) nameEncoding(names []string) (encoding.Encoding, error) {
+	var legacy []byte
+	for _, n := range names {
+		if !utf8.ValidString(n) {
+			legacy = append(legacy, n+"\n"...)
+		}
+	}
+	if len(legacy) == 0 || c.source != nil {
+		return c.source, nil
+	}
+	coding, err := chardet.DetectEncoding(legacy, c.detectOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot determine encoding: %w", err)
+	}
+	enc, err := parseEncoding(coding)
+	if err != nil {
+		return nil, err
+	}
+	if dec, err := enc.NewDecoder().Bytes(legacy); err != nil || !utf8.Valid(dec) || strings.ContainsRune(string(dec), utf8.RuneError) {
+		return nil, fmt.Errorf("detected encoding %s does not decode all names", coding)
+	}
+	return enc, nil
+}
+
+// convertName decodes a name that is not UTF-8 and normalizes it, it returns
+// false for names it cannot decode.
+func (c *renamer) convertName(name string, enc encoding.Encoding) (string, bool) {
+	if !utf8.ValidString(name) {
+		if enc == nil {
+			return "", false
+		}
+		dec, err := enc.NewDecoder().String(name)
+		if err != nil || strings.ContainsRune(dec, utf8.RuneError) || strings.ContainsRune(dec, '/') {
+			log.Printf("skipped %q, it does not decode as %s", name, enc)
+			return "", false
+		}
+		name = dec
+	}
+	switch c.Normalize {
+	case "nfc":
+		name = norm.NFC.String(name)
+	case "nfd":
+		name = norm.NFD.String(name)
+	}
+	return name, true
+}
+
+// sameFile reports whether both paths name one file, as on file systems that
+// ignore case or normalization.
+func sameFile(a, b string) bool {
+	sa, err := os.Lstat(a)
+	if err != nil {
+		return false
+	}
+	sb, err := os.Lstat(b)
+	return err == nil && os.SameFile(sa, sb)
+}
+
+// uniqueName adds the first free numbered suffix before the extension, like
+// notes (1).txt.
+func uniqueName(name string, taken map[string]bool) string {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for i := 1; ; i++ {
+		n := fmt.Sprintf("%s (%d)%s", base, i, ext)
+		if !taken[n] {
+			return n
+		}
+	}
+}
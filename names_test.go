@@ -0,0 +1,131 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+func legacyName(t *testing.T, enc encoding.Encoding, name string) string {
+	t.Helper()
+	n, err := enc.NewEncoder().String(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// tree creates the files, and their directories, under a new directory.
+func tree(t *testing.T, files ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, f := range files {
+		p := filepath.Join(dir, f)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(f), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// listTree returns the relative paths of all files under dir.
+func listTree(t *testing.T, dir string) (files []string) {
+	t.Helper()
+	filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			rel, _ := filepath.Rel(dir, p)
+			files = append(files, rel)
+		}
+		return err
+	})
+	slices.Sort(files)
+	return
+}
+
+func TestNames(t *testing.T) {
+	quietLog(t)
+	gbk := func(s string) string { return legacyName(t, simplifiedchinese.GBK, s) }
+	sjis := func(s string) string { return legacyName(t, japanese.ShiftJIS, s) }
+	files := []string{
+		gbk("会议纪要") + ".txt",
+		gbk("财务报表") + "/" + gbk("第一季度报告") + ".csv",
+		gbk("项目计划书") + ".doc",
+		"readme.txt",
+		"アニメ/" + sjis("テレビ番組の字幕ファイル") + ".srt",
+		"アニメ/" + sjis("映画のサウンドトラック") + ".mp3",
+	}
+
+	dir := tree(t, files...)
+	if _, err := transcode(t, nil, "names", "-r", dir); err != nil {
+		t.Fatal(err)
+	}
+	want := slices.Sorted(slices.Values(files))
+	if got := listTree(t, dir); !slices.Equal(got, want) {
+		t.Errorf("preview renamed files: %q", got)
+	}
+
+	if _, err := transcode(t, nil, "names", "-r", "-w", dir); err != nil {
+		t.Fatal(err)
+	}
+	want = []string{
+		"readme.txt",
+		"アニメ/テレビ番組の字幕ファイル.srt",
+		"アニメ/映画のサウンドトラック.mp3",
+		"会议纪要.txt",
+		"财务报表/第一季度报告.csv",
+		"项目计划书.doc",
+	}
+	if got := listTree(t, dir); !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestNamesCollision(t *testing.T) {
+	quietLog(t)
+	legacy := legacyName(t, simplifiedchinese.GBK, "说明") + ".txt"
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"--on-collision", "skip"}, []string{"说明.txt", legacy}},
+		{[]string{"--on-collision", "suffix"}, []string{"说明 (1).txt", "说明.txt"}},
+	}
+	for _, tt := range tests {
+		dir := tree(t, "说明.txt", legacy)
+		args := append([]string{"names", "-w", "-s", "gbk"}, tt.args...)
+		if _, err := transcode(t, nil, append(args, dir)...); err != nil {
+			t.Fatal(err)
+		}
+		got := listTree(t, dir)
+		slices.Sort(tt.want)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestNamesNormalize(t *testing.T) {
+	quietLog(t)
+	nfd, nfc := "Cafe\u0301.txt", "Caf\u00e9.txt"
+	dir := tree(t, nfd)
+	if _, err := transcode(t, nil, "names", "-w", dir); err != nil {
+		t.Fatal(err)
+	}
+	if got := listTree(t, dir); !slices.Equal(got, []string{nfd}) {
+		t.Errorf("renamed without --normalize: %q", got)
+	}
+	if _, err := transcode(t, nil, "names", "-w", "--normalize", "nfc", filepath.Join(dir, nfd)); err != nil {
+		t.Fatal(err)
+	}
+	if got := listTree(t, dir); !slices.Equal(got, []string{nfc}) {
+		t.Errorf("got %q, want %q", got, nfc)
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+//go:generate go run registry_gen.go
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+
+	"github.com/gonejack/transcode/chardet"
+	"github.com/gonejack/transcode/utfx"
+)
+
+type encodingInfo struct {
+	Name      string   `json:"name"`
+	Aliases   []string `json:"aliases,omitempty"`
+	MIME      string   `json:"mime,omitempty"`
+	IANA      string   `json:"iana,omitempty"`
+	CodePage  int      `json:"codepage,omitempty"`
+	MinBytes  int      `json:"min_bytes"`
+	MaxBytes  int      `json:"max_bytes"`
+	ASCII     bool     `json:"ascii_compatible"`
+	Languages []string `json:"languages,omitempty"`
+	Group     string   `json:"group"`
+
+	index string // "" for htmlindex, "iana" for ianaindex, "builtin" for builtinEncodings, "custom" for enc
+	enc   encoding.Encoding
+}
+
+var builtinEncodings = map[string]encoding.Encoding{
+	chardet.UTF8WithBOM:    unicode.UTF8BOM,
+	chardet.UTF16LEWithBOM: unicode.UTF16(unicode.LittleEndian, unicode.UseBOM),
+	chardet.UTF16BEWithBOM: unicode.UTF16(unicode.BigEndian, unicode.UseBOM),
+	chardet.UTF32LEWithBOM: utf32.UTF32(utf32.LittleEndian, utf32.UseBOM),
+	chardet.UTF32BEWithBOM: utf32.UTF32(utf32.BigEndian, utf32.UseBOM),
+	"utf-32":               utf32.UTF32(utf32.BigEndian, utf32.UseBOM),
+	"utf-32le":             utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM),
+	"utf-32be":             utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM),
+	"utf-7":                utfx.UTF7,
+	chardet.UTF7WithBOM:    utfx.WithBOM(utfx.UTF7),
+	chardet.GB18030WithBOM: utfx.WithBOM(simplifiedchinese.GB18030),
+	"cesu-8":               utfx.CESU8,
+	"modified-utf-8":       utfx.ModifiedUTF8,
+}
+
+var registryIndex = func() map[string]*encodingInfo {
+	m := make(map[string]*encodingInfo)
+	for i := range registry {
+		info := &registry[i]
+		m[info.Name] = info
+		for _, a := range info.Aliases {
+			m[a] = info
+		}
+	}
+	return m
+}()
+
+func lookupEncoding(name string) (*encodingInfo, bool) {
+	info, ok := registryIndex[strings.ToLower(strings.TrimSpace(name))]
+	return info, ok
+}
+
+// charsetLabel returns the name to declare for an encoding in HTML, XML, CSS
+// or a coding cookie, where BOM variants are not valid names.
+func charsetLabel(name string) string {
+	info, ok := lookupEncoding(name)
+	if !ok {
+		return name
+	}
+	return strings.TrimSuffix(info.Name, "-bom")
+}
+
+func (e *encodingInfo) encoding() (encoding.Encoding, error) {
+	switch e.index {
+	case "iana":
+		return ianaindex.IANA.Encoding(e.IANA)
+	case "custom":
+		return e.enc, nil
+	case "builtin":
+		if enc, ok := builtinEncodings[e.Name]; ok {
+			return enc, nil
+		}
+		return nil, fmt.Errorf("unknown builtin encoding %s", e.Name)
+	default:
+		return htmlindex.Get(e.Name)
+	}
+}
+
+func (e *encodingInfo) width() string {
+	if e.MinBytes == e.MaxBytes {
+		return strconv.Itoa(e.MinBytes)
+	}
+	return fmt.Sprintf("%d-%d", e.MinBytes, e.MaxBytes)
+}
+
+func encodings() []string {
+	list := make([]string, len(registry))
+	for i, e := range registry {
+		list[i] = e.Name
+	}
+	return list
+}
+
+func printEncodings(w io.Writer, format string) error {
+	if format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(registry)
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	group := ""
+	for _, e := range registry {
+		if e.Group != group {
+			if group != "" {
+				fmt.Fprintln(tw)
+			}
+			group = e.Group
+			fmt.Fprintf(tw, "%s:\n", group)
+			fmt.Fprintln(tw, "  NAME\tCODEPAGE\tBYTES\tASCII\tLANGUAGES\tALIASES")
+		}
+		cp := "-"
+		if e.CodePage > 0 {
+			cp = strconv.Itoa(e.CodePage)
+		}
+		ascii := "no"
+		if e.ASCII {
+			ascii = "yes"
+		}
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\t%s\n", e.Name, cp, e.width(), ascii,
+			strings.Join(e.Languages, ","), strings.Join(e.Aliases, ", "))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,440 @@
+//go:build ignore
+
+// This program generates registry_table.go from the label tables of the
+// golang.org/x/text version pinned in go.mod. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+
+	"github.com/gonejack/transcode/utfx"
+)
+
+type meta struct {
+	name      string
+	group     string
+	codepage  int
+	languages []string
+}
+
+var western = []string{"da", "de", "en", "es", "fi", "fr", "is", "it", "nl", "no", "pt", "sv"}
+
+// metas holds what cannot be derived from x/text, in listing order.
+var metas = []meta{
+	{"utf-8", "Unicode", 65001, nil},
+	{"utf-8-bom", "Unicode", 65001, nil},
+	{"utf-16le", "Unicode", 1200, nil},
+	{"utf-16be", "Unicode", 1201, nil},
+	{"utf-16le-bom", "Unicode", 1200, nil},
+	{"utf-16be-bom", "Unicode", 1201, nil},
+	{"utf-32le-bom", "Unicode", 12000, nil},
+	{"utf-32be-bom", "Unicode", 12001, nil},
+	{"utf-32", "Unicode", 0, nil},
+	{"utf-32le", "Unicode", 12000, nil},
+	{"utf-32be", "Unicode", 12001, nil},
+	{"utf-7", "Unicode", 65000, nil},
+	{"utf-7-bom", "Unicode", 65000, nil},
+	{"cesu-8", "Unicode", 0, nil},
+	{"modified-utf-8", "Unicode", 0, nil},
+	{"windows-1252", "Western European", 1252, western},
+	{"iso-8859-15", "Western European", 28605, western},
+	{"macintosh", "Western European", 10000, western},
+	{"iso-8859-3", "Western European", 28593, []string{"eo", "mt"}},
+	{"iso-8859-10", "Western European", 0, []string{"da", "fo", "is", "kl", "no", "sv"}},
+	{"iso-8859-14", "Western European", 0, []string{"br", "cy", "ga", "gd"}},
+	{"windows-1250", "Central European", 1250, []string{"cs", "hr", "hu", "pl", "ro", "sk", "sl", "sq"}},
+	{"iso-8859-2", "Central European", 28592, []string{"cs", "hr", "hu", "pl", "ro", "sk", "sl", "sq"}},
+	{"iso-8859-16", "Central European", 0, []string{"hr", "hu", "pl", "ro", "sl", "sq"}},
+	{"windows-1257", "Baltic", 1257, []string{"et", "lt", "lv"}},
+	{"iso-8859-4", "Baltic", 28594, []string{"et", "kl", "lt", "lv"}},
+	{"iso-8859-13", "Baltic", 28603, []string{"et", "lt", "lv", "pl"}},
+	{"windows-1251", "Cyrillic", 1251, []string{"be", "bg", "mk", "ru", "sr", "uk"}},
+	{"iso-8859-5", "Cyrillic", 28595, []string{"be", "bg", "mk", "ru", "sr", "uk"}},
+	{"koi8-r", "Cyrillic", 20866, []string{"bg", "ru"}},
+	{"koi8-u", "Cyrillic", 21866, []string{"be", "ru", "uk"}},
+	{"ibm866", "Cyrillic", 866, []string{"be", "ru", "uk"}},
+	{"x-mac-cyrillic", "Cyrillic", 10007, []string{"be", "bg", "mk", "ru", "sr", "uk"}},
+	{"windows-1253", "Greek", 1253, []string{"el"}},
+	{"iso-8859-7", "Greek", 28597, []string{"el"}},
+	{"windows-1254", "Turkish", 1254, []string{"az", "tr"}},
+	{"windows-1255", "Hebrew", 1255, []string{"he", "yi"}},
+	{"iso-8859-8", "Hebrew", 28598, []string{"he"}},
+	{"iso-8859-8-i", "Hebrew", 38598, []string{"he"}},
+	{"windows-1256", "Arabic", 1256, []string{"ar", "fa", "ur"}},
+	{"iso-8859-6", "Arabic", 28596, []string{"ar"}},
+	{"windows-874", "Thai", 874, []string{"th"}},
+	{"windows-1258", "Vietnamese", 1258, []string{"vi"}},
+	{"gbk", "Chinese", 936, []string{"zh"}},
+	{"gb18030", "Chinese", 54936, []string{"zh"}},
+	{"gb18030-bom", "Chinese", 54936, []string{"zh"}},
+	{"hz-gb-2312", "Chinese", 52936, []string{"zh"}},
+	{"big5", "Chinese", 950, []string{"zh"}},
+	{"euc-jp", "Japanese", 51932, []string{"ja"}},
+	{"iso-2022-jp", "Japanese", 50220, []string{"ja"}},
+	{"shift_jis", "Japanese", 932, []string{"ja"}},
+	{"euc-kr", "Korean", 949, []string{"ko"}},
+	{"ibm437", "DOS", 437, []string{"en"}},
+	{"ibm850", "DOS", 850, western},
+	{"ibm00858", "DOS", 858, western},
+	{"ibm852", "DOS", 852, []string{"cs", "hr", "hu", "pl", "ro", "sk", "sl", "sq"}},
+	{"ibm855", "DOS", 855, []string{"bg", "mk", "ru", "sr"}},
+	{"ibm860", "DOS", 860, []string{"pt"}},
+	{"ibm862", "DOS", 862, []string{"he"}},
+	{"ibm863", "DOS", 863, []string{"fr"}},
+	{"ibm865", "DOS", 865, []string{"da", "no"}},
+	{"ibm037", "EBCDIC", 37, []string{"en", "fr", "nl", "pt"}},
+	{"ibm1047", "EBCDIC", 1047, []string{"en"}},
+	{"ibm01140", "EBCDIC", 1140, []string{"en", "fr", "nl", "pt"}},
+	{"replacement", "Miscellaneous", 0, nil},
+	{"x-user-defined", "Miscellaneous", 0, nil},
+}
+
+// shadowed lists the charmap encodings whose every name is a WHATWG label of
+// another encoding, so they cannot be selected by name.
+var shadowed = []encoding.Encoding{
+	charmap.ISO8859_1,  // windows-1252
+	charmap.ISO8859_9,  // windows-1254
+	charmap.ISO8859_6E, // iso-8859-6, same mapping
+	charmap.ISO8859_6I, // iso-8859-6, same mapping
+	charmap.ISO8859_8E, // iso-8859-8, same mapping
+}
+
+// synthetic lists the names transcode implements on top of x/text, together
+// with the BOM-less encoding used to measure them.
+var synthetic = []struct {
+	name    string
+	aliases []string
+	iana    string
+	measure encoding.Encoding
+}{
+	{"utf-8-bom", []string{"utf-8-sig"}, "", unicode.UTF8},
+	{"utf-16le-bom", nil, "", unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)},
+	{"utf-16be-bom", nil, "", unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)},
+	{"utf-32le-bom", nil, "", utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM)},
+	{"utf-32be-bom", nil, "", utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM)},
+	{"utf-32", []string{"csutf32", "ucs-4"}, "UTF-32", utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM)},
+	{"utf-32le", []string{"csutf32le"}, "UTF-32LE", utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM)},
+	{"utf-32be", []string{"csutf32be"}, "UTF-32BE", utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM)},
+	{"utf-7", []string{"csutf7", "unicode-1-1-utf-7"}, "UTF-7", utfx.UTF7},
+	{"utf-7-bom", nil, "", utfx.UTF7},
+	{"gb18030-bom", nil, "", simplifiedchinese.GB18030},
+	{"cesu-8", []string{"cscesu-8", "cscesu8"}, "CESU-8", utfx.CESU8},
+	{"modified-utf-8", []string{"java-modified-utf-8", "mutf-8"}, "", utfx.ModifiedUTF8},
+}
+
+type entry struct {
+	name     string
+	aliases  []string
+	index    string
+	enc      encoding.Encoding
+	mime     string
+	iana     string
+	min, max int
+	ascii    bool
+	meta
+}
+
+func main() {
+	dir, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "golang.org/x/text").Output()
+	if err != nil {
+		log.Fatalf("locate golang.org/x/text: %s", err)
+	}
+	textDir := filepath.Join(strings.TrimSpace(string(dir)), "encoding")
+	labels, err := htmlLabels(filepath.Join(textDir, "htmlindex", "tables.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	ianaLabels, err := ianaAliases(filepath.Join(textDir, "ianaindex", "tables.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var list []*entry
+	var taken = map[string]*entry{}
+	for name, aliases := range labels {
+		enc, err := htmlindex.Get(name)
+		if err != nil {
+			log.Fatalf("htmlindex %s: %s", name, err)
+		}
+		e := &entry{name: name, aliases: aliases, enc: enc}
+		for _, a := range aliases {
+			taken[a] = e
+		}
+		list = append(list, e)
+	}
+
+	// WHATWG labels take precedence. IANA aliases of an encoding htmlindex
+	// already knows, or of a label it remaps, join that entry; only IANA
+	// encodings that are new, or that WHATWG replaces with the replacement
+	// encoding, get an entry of their own.
+	for _, aliases := range ianaLabels {
+		enc, err := ianaindex.IANA.Encoding(aliases[0])
+		if err != nil || enc == nil {
+			continue
+		}
+		var target *entry
+		if name, err := htmlindex.Name(enc); err == nil {
+			target = taken[name]
+		}
+		for _, a := range aliases {
+			if e := taken[a]; target == nil && e != nil && e.enc != encoding.Replacement {
+				target = e
+			}
+		}
+		if target == nil {
+			name, _ := ianaindex.IANA.Name(enc)
+			target = &entry{name: strings.ToLower(name), index: "iana", enc: enc}
+			list = append(list, target)
+		}
+		for _, a := range aliases {
+			if e := taken[a]; e == nil || e.enc == encoding.Replacement && target.index == "iana" {
+				if e != nil {
+					e.aliases = slices.DeleteFunc(e.aliases, func(s string) bool { return s == a })
+				}
+				taken[a] = target
+				target.aliases = append(target.aliases, a)
+			}
+		}
+	}
+	for _, s := range synthetic {
+		list = append(list, &entry{name: s.name, aliases: s.aliases, index: "builtin", enc: s.measure, mime: s.iana, iana: s.iana})
+	}
+
+	for _, e := range list {
+		if e.index != "builtin" {
+			e.mime, _ = ianaindex.MIME.Name(e.enc)
+			e.iana, _ = ianaindex.IANA.Name(e.enc)
+		}
+		i := metaIndex(e.name)
+		if i < 0 {
+			log.Fatalf("missing metadata for %s", e.name)
+		}
+		e.meta = metas[i]
+		if strings.HasPrefix(e.iana, "IBM") && e.codepage > 0 {
+			for _, f := range []string{"cp%03d", "ibm%03d", "ibm-%03d"} {
+				if a := fmt.Sprintf(f, e.codepage); taken[a] == nil {
+					taken[a] = e
+					e.aliases = append(e.aliases, a)
+				}
+			}
+		}
+		e.aliases = slices.DeleteFunc(e.aliases, func(a string) bool { return a == e.name })
+		slices.Sort(e.aliases)
+		e.aliases = slices.Compact(e.aliases)
+		e.min, e.max = byteWidth(e.enc)
+		e.ascii = asciiCompatible(e.enc)
+	}
+	for _, c := range charmap.All {
+		if !slices.ContainsFunc(list, func(e *entry) bool { return e.enc == c }) && !slices.Contains(shadowed, c) {
+			log.Fatalf("charmap %s is not reachable", c)
+		}
+	}
+	slices.SortFunc(list, func(a, b *entry) int {
+		return metaIndex(a.name) - metaIndex(b.name)
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by registry_gen.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package main")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var registry = []encodingInfo{")
+	for _, e := range list {
+		fmt.Fprintf(&buf, "{\nName: %q,\n", e.name)
+		if len(e.aliases) > 0 {
+			fmt.Fprintf(&buf, "Aliases: %s,\n", stringSlice(e.aliases))
+		}
+		if e.mime != "" {
+			fmt.Fprintf(&buf, "MIME: %q,\n", e.mime)
+		}
+		if e.iana != "" {
+			fmt.Fprintf(&buf, "IANA: %q,\n", e.iana)
+		}
+		if e.codepage != 0 {
+			fmt.Fprintf(&buf, "CodePage: %d,\n", e.codepage)
+		}
+		fmt.Fprintf(&buf, "MinBytes: %d,\nMaxBytes: %d,\n", e.min, e.max)
+		if e.ascii {
+			fmt.Fprintln(&buf, "ASCII: true,")
+		}
+		if len(e.languages) > 0 {
+			fmt.Fprintf(&buf, "Languages: %s,\n", stringSlice(e.languages))
+		}
+		fmt.Fprintf(&buf, "Group: %q,\n", e.group)
+		if e.index != "" {
+			fmt.Fprintf(&buf, "index: %q,\n", e.index)
+		}
+		fmt.Fprintln(&buf, "},")
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format: %s", err)
+	}
+	if err = os.WriteFile("registry_table.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func metaIndex(name string) int {
+	return slices.IndexFunc(metas, func(m meta) bool { return m.name == name })
+}
+
+// htmlLabels reads the WHATWG label table of htmlindex and groups the labels
+// by canonical name.
+func htmlLabels(file string) (map[string][]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var consts []string
+	var canonical []string
+	var labels = map[string][]string{}
+	var names = map[string]string{}
+	for _, d := range f.Decls {
+		g, ok := d.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, s := range g.Specs {
+			vs, ok := s.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			switch {
+			case g.Tok == token.CONST:
+				consts = append(consts, vs.Names[0].Name)
+			case vs.Names[0].Name == "canonical":
+				for _, v := range vs.Values[0].(*ast.CompositeLit).Elts {
+					s, _ := strconv.Unquote(v.(*ast.BasicLit).Value)
+					canonical = append(canonical, s)
+				}
+			case vs.Names[0].Name == "nameMap":
+				for _, v := range vs.Values[0].(*ast.CompositeLit).Elts {
+					kv := v.(*ast.KeyValueExpr)
+					s, _ := strconv.Unquote(kv.Key.(*ast.BasicLit).Value)
+					names[s] = kv.Value.(*ast.Ident).Name
+				}
+			}
+		}
+	}
+	if len(canonical) == 0 || len(names) == 0 {
+		return nil, fmt.Errorf("no label tables found in %s", file)
+	}
+	for label, c := range names {
+		i := slices.Index(consts, c)
+		if i < 0 || i >= len(canonical) {
+			return nil, fmt.Errorf("unknown htmlindex constant %s", c)
+		}
+		labels[canonical[i]] = append(labels[canonical[i]], label)
+	}
+	return labels, nil
+}
+
+// ianaAliases reads the alias table of ianaindex and groups the lowercased
+// aliases by encoding, in table order.
+func ianaAliases(file string) ([][]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var groups [][]string
+	var index = map[string]int{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		vs, ok := n.(*ast.ValueSpec)
+		if !ok || vs.Names[0].Name != "ianaAliases" {
+			return true
+		}
+		for _, v := range vs.Values[0].(*ast.CompositeLit).Elts {
+			kv := v.(*ast.KeyValueExpr)
+			s, _ := strconv.Unquote(kv.Key.(*ast.BasicLit).Value)
+			id := kv.Value.(*ast.Ident).Name
+			i, ok := index[id]
+			if !ok {
+				i = len(groups)
+				index[id] = i
+				groups = append(groups, nil)
+			}
+			if s = strings.ToLower(s); !slices.Contains(groups[i], s) {
+				groups[i] = append(groups[i], s)
+			}
+		}
+		return false
+	})
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("no alias table found in %s", file)
+	}
+	return groups, nil
+}
+
+// byteWidth reports the shortest and longest byte sequence enc produces for a
+// single character. Every character is encoded twice in a row so the escape
+// sequences of stateful encodings are not counted.
+func byteWidth(enc encoding.Encoding) (lo, hi int) {
+	e := enc.NewEncoder()
+	lo = 1 << 10
+	var buf [2 * utf8.UTFMax]byte
+	for r := rune(0); r <= utf8.MaxRune; r++ {
+		if !utf8.ValidRune(r) {
+			continue
+		}
+		n := utf8.EncodeRune(buf[:], r)
+		one, err := e.Bytes(buf[:n])
+		if err != nil {
+			continue
+		}
+		copy(buf[n:], buf[:n])
+		two, err := e.Bytes(buf[:2*n])
+		if err != nil {
+			continue
+		}
+		w := len(two) - len(one)
+		if w > 0 {
+			lo, hi = min(lo, w), max(hi, w)
+		}
+	}
+	return
+}
+
+func asciiCompatible(enc encoding.Encoding) bool {
+	var ascii [utf8.RuneSelf]byte
+	for i := range ascii {
+		ascii[i] = byte(i)
+	}
+	dec, err := enc.NewDecoder().Bytes(ascii[:])
+	if err != nil || !bytes.Equal(dec, ascii[:]) {
+		return false
+	}
+	out, err := enc.NewEncoder().Bytes(ascii[:])
+	return err == nil && bytes.Equal(out, ascii[:])
+}
+
+func stringSlice(list []string) string {
+	q := make([]string, len(list))
+	for i, s := range list {
+		q[i] = strconv.Quote(s)
+	}
+	return "[]string{" + strings.Join(q, ", ") + "}"
+}
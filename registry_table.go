@@ -0,0 +1,751 @@
+// Code generated by registry_gen.go; DO NOT EDIT.
+
+package main
+
+var registry = []encodingInfo{
+	{
+		Name:     "utf-8",
+		Aliases:  []string{"csutf8", "unicode-1-1-utf-8", "unicode11utf8", "unicode20utf8", "utf8", "x-unicode20utf8"},
+		MIME:     "UTF-8",
+		IANA:     "UTF-8",
+		CodePage: 65001,
+		MinBytes: 1,
+		MaxBytes: 4,
+		ASCII:    true,
+		Group:    "Unicode",
+	},
+	{
+		Name:     "utf-8-bom",
+		Aliases:  []string{"utf-8-sig"},
+		CodePage: 65001,
+		MinBytes: 1,
+		MaxBytes: 4,
+		ASCII:    true,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "utf-16le",
+		Aliases:  []string{"csunicode", "csutf16", "csutf16le", "iso-10646-ucs-2", "ucs-2", "unicode", "unicodefeff", "utf-16"},
+		MIME:     "UTF-16LE",
+		IANA:     "UTF-16LE",
+		CodePage: 1200,
+		MinBytes: 2,
+		MaxBytes: 4,
+		Group:    "Unicode",
+	},
+	{
+		Name:     "utf-16be",
+		Aliases:  []string{"csutf16be", "unicodefffe"},
+		MIME:     "UTF-16BE",
+		IANA:     "UTF-16BE",
+		CodePage: 1201,
+		MinBytes: 2,
+		MaxBytes: 4,
+		Group:    "Unicode",
+	},
+	{
+		Name:     "utf-16le-bom",
+		CodePage: 1200,
+		MinBytes: 2,
+		MaxBytes: 4,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "utf-16be-bom",
+		CodePage: 1201,
+		MinBytes: 2,
+		MaxBytes: 4,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "utf-32le-bom",
+		CodePage: 12000,
+		MinBytes: 4,
+		MaxBytes: 4,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "utf-32be-bom",
+		CodePage: 12001,
+		MinBytes: 4,
+		MaxBytes: 4,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "utf-32",
+		Aliases:  []string{"csutf32", "ucs-4"},
+		MIME:     "UTF-32",
+		IANA:     "UTF-32",
+		MinBytes: 4,
+		MaxBytes: 4,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "utf-32le",
+		Aliases:  []string{"csutf32le"},
+		MIME:     "UTF-32LE",
+		IANA:     "UTF-32LE",
+		CodePage: 12000,
+		MinBytes: 4,
+		MaxBytes: 4,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "utf-32be",
+		Aliases:  []string{"csutf32be"},
+		MIME:     "UTF-32BE",
+		IANA:     "UTF-32BE",
+		CodePage: 12001,
+		MinBytes: 4,
+		MaxBytes: 4,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "utf-7",
+		Aliases:  []string{"csutf7", "unicode-1-1-utf-7"},
+		MIME:     "UTF-7",
+		IANA:     "UTF-7",
+		CodePage: 65000,
+		MinBytes: 1,
+		MaxBytes: 5,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "utf-7-bom",
+		CodePage: 65000,
+		MinBytes: 1,
+		MaxBytes: 5,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "cesu-8",
+		Aliases:  []string{"cscesu-8", "cscesu8"},
+		MIME:     "CESU-8",
+		IANA:     "CESU-8",
+		MinBytes: 1,
+		MaxBytes: 6,
+		ASCII:    true,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:     "modified-utf-8",
+		Aliases:  []string{"java-modified-utf-8", "mutf-8"},
+		MinBytes: 1,
+		MaxBytes: 6,
+		Group:    "Unicode",
+		index:    "builtin",
+	},
+	{
+		Name:      "windows-1252",
+		Aliases:   []string{"ansi_x3.4-1968", "ansi_x3.4-1986", "ascii", "cp1252", "cp367", "cp819", "csascii", "csisolatin1", "cswindows1252", "ibm367", "ibm819", "iso-8859-1", "iso-ir-100", "iso-ir-6", "iso646-us", "iso8859-1", "iso88591", "iso_646.irv:1991", "iso_8859-1", "iso_8859-1:1987", "l1", "latin1", "us", "us-ascii", "x-cp1252"},
+		MIME:      "windows-1252",
+		IANA:      "windows-1252",
+		CodePage:  1252,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"da", "de", "en", "es", "fi", "fr", "is", "it", "nl", "no", "pt", "sv"},
+		Group:     "Western European",
+	},
+	{
+		Name:      "iso-8859-15",
+		Aliases:   []string{"csiso885915", "csisolatin9", "iso8859-15", "iso885915", "iso_8859-15", "l9", "latin-9"},
+		MIME:      "ISO-8859-15",
+		IANA:      "ISO-8859-15",
+		CodePage:  28605,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"da", "de", "en", "es", "fi", "fr", "is", "it", "nl", "no", "pt", "sv"},
+		Group:     "Western European",
+	},
+	{
+		Name:      "macintosh",
+		Aliases:   []string{"csmacintosh", "mac", "x-mac-roman"},
+		MIME:      "macintosh",
+		IANA:      "macintosh",
+		CodePage:  10000,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"da", "de", "en", "es", "fi", "fr", "is", "it", "nl", "no", "pt", "sv"},
+		Group:     "Western European",
+	},
+	{
+		Name:      "iso-8859-3",
+		Aliases:   []string{"csisolatin3", "iso-ir-109", "iso8859-3", "iso88593", "iso_8859-3", "iso_8859-3:1988", "l3", "latin3"},
+		MIME:      "ISO-8859-3",
+		IANA:      "ISO_8859-3:1988",
+		CodePage:  28593,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"eo", "mt"},
+		Group:     "Western European",
+	},
+	{
+		Name:      "iso-8859-10",
+		Aliases:   []string{"csisolatin6", "iso-ir-157", "iso8859-10", "iso885910", "iso_8859-10:1992", "l6", "latin6"},
+		MIME:      "ISO-8859-10",
+		IANA:      "ISO-8859-10",
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"da", "fo", "is", "kl", "no", "sv"},
+		Group:     "Western European",
+	},
+	{
+		Name:      "iso-8859-14",
+		Aliases:   []string{"csiso885914", "iso-celtic", "iso-ir-199", "iso8859-14", "iso885914", "iso_8859-14", "iso_8859-14:1998", "l8", "latin8"},
+		MIME:      "ISO-8859-14",
+		IANA:      "ISO-8859-14",
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"br", "cy", "ga", "gd"},
+		Group:     "Western European",
+	},
+	{
+		Name:      "windows-1250",
+		Aliases:   []string{"cp1250", "cswindows1250", "x-cp1250"},
+		MIME:      "windows-1250",
+		IANA:      "windows-1250",
+		CodePage:  1250,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"cs", "hr", "hu", "pl", "ro", "sk", "sl", "sq"},
+		Group:     "Central European",
+	},
+	{
+		Name:      "iso-8859-2",
+		Aliases:   []string{"csisolatin2", "iso-ir-101", "iso8859-2", "iso88592", "iso_8859-2", "iso_8859-2:1987", "l2", "latin2"},
+		MIME:      "ISO-8859-2",
+		IANA:      "ISO_8859-2:1987",
+		CodePage:  28592,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"cs", "hr", "hu", "pl", "ro", "sk", "sl", "sq"},
+		Group:     "Central European",
+	},
+	{
+		Name:      "iso-8859-16",
+		Aliases:   []string{"csiso885916", "iso-ir-226", "iso_8859-16", "iso_8859-16:2001", "l10", "latin10"},
+		MIME:      "ISO-8859-16",
+		IANA:      "ISO-8859-16",
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"hr", "hu", "pl", "ro", "sl", "sq"},
+		Group:     "Central European",
+	},
+	{
+		Name:      "windows-1257",
+		Aliases:   []string{"cp1257", "cswindows1257", "x-cp1257"},
+		MIME:      "windows-1257",
+		IANA:      "windows-1257",
+		CodePage:  1257,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"et", "lt", "lv"},
+		Group:     "Baltic",
+	},
+	{
+		Name:      "iso-8859-4",
+		Aliases:   []string{"csisolatin4", "iso-ir-110", "iso8859-4", "iso88594", "iso_8859-4", "iso_8859-4:1988", "l4", "latin4"},
+		MIME:      "ISO-8859-4",
+		IANA:      "ISO_8859-4:1988",
+		CodePage:  28594,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"et", "kl", "lt", "lv"},
+		Group:     "Baltic",
+	},
+	{
+		Name:      "iso-8859-13",
+		Aliases:   []string{"csiso885913", "iso8859-13", "iso885913"},
+		MIME:      "ISO-8859-13",
+		IANA:      "ISO-8859-13",
+		CodePage:  28603,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"et", "lt", "lv", "pl"},
+		Group:     "Baltic",
+	},
+	{
+		Name:      "windows-1251",
+		Aliases:   []string{"cp1251", "cswindows1251", "x-cp1251"},
+		MIME:      "windows-1251",
+		IANA:      "windows-1251",
+		CodePage:  1251,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"be", "bg", "mk", "ru", "sr", "uk"},
+		Group:     "Cyrillic",
+	},
+	{
+		Name:      "iso-8859-5",
+		Aliases:   []string{"csisolatincyrillic", "cyrillic", "iso-ir-144", "iso8859-5", "iso88595", "iso_8859-5", "iso_8859-5:1988"},
+		MIME:      "ISO-8859-5",
+		IANA:      "ISO_8859-5:1988",
+		CodePage:  28595,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"be", "bg", "mk", "ru", "sr", "uk"},
+		Group:     "Cyrillic",
+	},
+	{
+		Name:      "koi8-r",
+		Aliases:   []string{"cskoi8r", "koi", "koi8", "koi8_r"},
+		MIME:      "KOI8-R",
+		IANA:      "KOI8-R",
+		CodePage:  20866,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"bg", "ru"},
+		Group:     "Cyrillic",
+	},
+	{
+		Name:      "koi8-u",
+		Aliases:   []string{"cskoi8u", "koi8-ru"},
+		MIME:      "KOI8-U",
+		IANA:      "KOI8-U",
+		CodePage:  21866,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"be", "ru", "uk"},
+		Group:     "Cyrillic",
+	},
+	{
+		Name:      "ibm866",
+		Aliases:   []string{"866", "cp866", "csibm866", "ibm-866"},
+		MIME:      "IBM866",
+		IANA:      "IBM866",
+		CodePage:  866,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"be", "ru", "uk"},
+		Group:     "Cyrillic",
+	},
+	{
+		Name:      "x-mac-cyrillic",
+		Aliases:   []string{"x-mac-ukrainian"},
+		CodePage:  10007,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"be", "bg", "mk", "ru", "sr", "uk"},
+		Group:     "Cyrillic",
+	},
+	{
+		Name:      "windows-1253",
+		Aliases:   []string{"cp1253", "cswindows1253", "x-cp1253"},
+		MIME:      "windows-1253",
+		IANA:      "windows-1253",
+		CodePage:  1253,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"el"},
+		Group:     "Greek",
+	},
+	{
+		Name:      "iso-8859-7",
+		Aliases:   []string{"csisolatingreek", "ecma-118", "elot_928", "greek", "greek8", "iso-ir-126", "iso8859-7", "iso88597", "iso_8859-7", "iso_8859-7:1987", "sun_eu_greek"},
+		MIME:      "ISO-8859-7",
+		IANA:      "ISO_8859-7:1987",
+		CodePage:  28597,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"el"},
+		Group:     "Greek",
+	},
+	{
+		Name:      "windows-1254",
+		Aliases:   []string{"cp1254", "csisolatin5", "cswindows1254", "iso-8859-9", "iso-ir-148", "iso8859-9", "iso88599", "iso_8859-9", "iso_8859-9:1989", "l5", "latin5", "x-cp1254"},
+		MIME:      "windows-1254",
+		IANA:      "windows-1254",
+		CodePage:  1254,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"az", "tr"},
+		Group:     "Turkish",
+	},
+	{
+		Name:      "windows-1255",
+		Aliases:   []string{"cp1255", "cswindows1255", "x-cp1255"},
+		MIME:      "windows-1255",
+		IANA:      "windows-1255",
+		CodePage:  1255,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"he", "yi"},
+		Group:     "Hebrew",
+	},
+	{
+		Name:      "iso-8859-8",
+		Aliases:   []string{"csiso88598e", "csisolatinhebrew", "hebrew", "iso-8859-8-e", "iso-ir-138", "iso8859-8", "iso88598", "iso_8859-8", "iso_8859-8-e", "iso_8859-8:1988", "visual"},
+		MIME:      "ISO-8859-8",
+		IANA:      "ISO_8859-8:1988",
+		CodePage:  28598,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"he"},
+		Group:     "Hebrew",
+	},
+	{
+		Name:      "iso-8859-8-i",
+		Aliases:   []string{"csiso88598i", "iso_8859-8-i", "logical"},
+		MIME:      "ISO-8859-8-I",
+		IANA:      "ISO_8859-8-I",
+		CodePage:  38598,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"he"},
+		Group:     "Hebrew",
+	},
+	{
+		Name:      "windows-1256",
+		Aliases:   []string{"cp1256", "cswindows1256", "x-cp1256"},
+		MIME:      "windows-1256",
+		IANA:      "windows-1256",
+		CodePage:  1256,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"ar", "fa", "ur"},
+		Group:     "Arabic",
+	},
+	{
+		Name:      "iso-8859-6",
+		Aliases:   []string{"arabic", "asmo-708", "csiso88596e", "csiso88596i", "csisolatinarabic", "ecma-114", "iso-8859-6-e", "iso-8859-6-i", "iso-ir-127", "iso8859-6", "iso88596", "iso_8859-6", "iso_8859-6-e", "iso_8859-6-i", "iso_8859-6:1987"},
+		MIME:      "ISO-8859-6",
+		IANA:      "ISO_8859-6:1987",
+		CodePage:  28596,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"ar"},
+		Group:     "Arabic",
+	},
+	{
+		Name:      "windows-874",
+		Aliases:   []string{"cswindows874", "dos-874", "iso-8859-11", "iso8859-11", "iso885911", "tis-620"},
+		MIME:      "windows-874",
+		IANA:      "windows-874",
+		CodePage:  874,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"th"},
+		Group:     "Thai",
+	},
+	{
+		Name:      "windows-1258",
+		Aliases:   []string{"cp1258", "cswindows1258", "x-cp1258"},
+		MIME:      "windows-1258",
+		IANA:      "windows-1258",
+		CodePage:  1258,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"vi"},
+		Group:     "Vietnamese",
+	},
+	{
+		Name:      "gbk",
+		Aliases:   []string{"chinese", "cp936", "csgb2312", "csgbk", "csiso58gb231280", "gb2312", "gb_2312", "gb_2312-80", "iso-ir-58", "ms936", "windows-936", "x-gbk"},
+		MIME:      "GBK",
+		IANA:      "GBK",
+		CodePage:  936,
+		MinBytes:  1,
+		MaxBytes:  2,
+		ASCII:     true,
+		Languages: []string{"zh"},
+		Group:     "Chinese",
+	},
+	{
+		Name:      "gb18030",
+		Aliases:   []string{"csgb18030"},
+		MIME:      "GB18030",
+		IANA:      "GB18030",
+		CodePage:  54936,
+		MinBytes:  1,
+		MaxBytes:  4,
+		ASCII:     true,
+		Languages: []string{"zh"},
+		Group:     "Chinese",
+	},
+	{
+		Name:      "gb18030-bom",
+		CodePage:  54936,
+		MinBytes:  1,
+		MaxBytes:  4,
+		ASCII:     true,
+		Languages: []string{"zh"},
+		Group:     "Chinese",
+		index:     "builtin",
+	},
+	{
+		Name:      "hz-gb-2312",
+		MIME:      "HZ-GB-2312",
+		IANA:      "HZ-GB-2312",
+		CodePage:  52936,
+		MinBytes:  1,
+		MaxBytes:  2,
+		Languages: []string{"zh"},
+		Group:     "Chinese",
+		index:     "iana",
+	},
+	{
+		Name:      "big5",
+		Aliases:   []string{"big5-hkscs", "cn-big5", "csbig5", "x-x-big5"},
+		MIME:      "Big5",
+		IANA:      "Big5",
+		CodePage:  950,
+		MinBytes:  1,
+		MaxBytes:  2,
+		ASCII:     true,
+		Languages: []string{"zh"},
+		Group:     "Chinese",
+	},
+	{
+		Name:      "euc-jp",
+		Aliases:   []string{"cseucpkdfmtjapanese", "extended_unix_code_packed_format_for_japanese", "x-euc-jp"},
+		MIME:      "EUC-JP",
+		IANA:      "Extended_UNIX_Code_Packed_Format_for_Japanese",
+		CodePage:  51932,
+		MinBytes:  1,
+		MaxBytes:  3,
+		ASCII:     true,
+		Languages: []string{"ja"},
+		Group:     "Japanese",
+	},
+	{
+		Name:      "iso-2022-jp",
+		Aliases:   []string{"csiso2022jp"},
+		MIME:      "ISO-2022-JP",
+		IANA:      "ISO-2022-JP",
+		CodePage:  50220,
+		MinBytes:  1,
+		MaxBytes:  2,
+		Languages: []string{"ja"},
+		Group:     "Japanese",
+	},
+	{
+		Name:      "shift_jis",
+		Aliases:   []string{"csshiftjis", "ms932", "ms_kanji", "shift-jis", "sjis", "windows-31j", "x-sjis"},
+		MIME:      "Shift_JIS",
+		IANA:      "Shift_JIS",
+		CodePage:  932,
+		MinBytes:  1,
+		MaxBytes:  2,
+		ASCII:     true,
+		Languages: []string{"ja"},
+		Group:     "Japanese",
+	},
+	{
+		Name:      "euc-kr",
+		Aliases:   []string{"cseuckr", "csksc56011987", "iso-ir-149", "korean", "ks_c_5601-1987", "ks_c_5601-1989", "ksc5601", "ksc_5601", "windows-949"},
+		MIME:      "EUC-KR",
+		IANA:      "EUC-KR",
+		CodePage:  949,
+		MinBytes:  1,
+		MaxBytes:  2,
+		ASCII:     true,
+		Languages: []string{"ko"},
+		Group:     "Korean",
+	},
+	{
+		Name:      "ibm437",
+		Aliases:   []string{"437", "cp437", "cspc8codepage437", "ibm-437"},
+		MIME:      "IBM437",
+		IANA:      "IBM437",
+		CodePage:  437,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"en"},
+		Group:     "DOS",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm850",
+		Aliases:   []string{"850", "cp850", "cspc850multilingual", "ibm-850"},
+		MIME:      "IBM850",
+		IANA:      "IBM850",
+		CodePage:  850,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"da", "de", "en", "es", "fi", "fr", "is", "it", "nl", "no", "pt", "sv"},
+		Group:     "DOS",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm00858",
+		Aliases:   []string{"ccsid00858", "cp00858", "cp858", "csibm00858", "ibm-858", "ibm858", "pc-multilingual-850+euro"},
+		MIME:      "IBM00858",
+		IANA:      "IBM00858",
+		CodePage:  858,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"da", "de", "en", "es", "fi", "fr", "is", "it", "nl", "no", "pt", "sv"},
+		Group:     "DOS",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm852",
+		Aliases:   []string{"852", "cp852", "cspcp852", "ibm-852"},
+		MIME:      "IBM852",
+		IANA:      "IBM852",
+		CodePage:  852,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"cs", "hr", "hu", "pl", "ro", "sk", "sl", "sq"},
+		Group:     "DOS",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm855",
+		Aliases:   []string{"855", "cp855", "csibm855", "ibm-855"},
+		MIME:      "IBM855",
+		IANA:      "IBM855",
+		CodePage:  855,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"bg", "mk", "ru", "sr"},
+		Group:     "DOS",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm860",
+		Aliases:   []string{"860", "cp860", "csibm860", "ibm-860"},
+		MIME:      "IBM860",
+		IANA:      "IBM860",
+		CodePage:  860,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"pt"},
+		Group:     "DOS",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm862",
+		Aliases:   []string{"862", "cp862", "cspc862latinhebrew", "ibm-862"},
+		MIME:      "IBM862",
+		IANA:      "IBM862",
+		CodePage:  862,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"he"},
+		Group:     "DOS",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm863",
+		Aliases:   []string{"863", "cp863", "csibm863", "ibm-863"},
+		MIME:      "IBM863",
+		IANA:      "IBM863",
+		CodePage:  863,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"fr"},
+		Group:     "DOS",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm865",
+		Aliases:   []string{"865", "cp865", "csibm865", "ibm-865"},
+		MIME:      "IBM865",
+		IANA:      "IBM865",
+		CodePage:  865,
+		MinBytes:  1,
+		MaxBytes:  1,
+		ASCII:     true,
+		Languages: []string{"da", "no"},
+		Group:     "DOS",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm037",
+		Aliases:   []string{"cp037", "csibm037", "ebcdic-cp-ca", "ebcdic-cp-nl", "ebcdic-cp-us", "ebcdic-cp-wt", "ibm-037"},
+		MIME:      "IBM037",
+		IANA:      "IBM037",
+		CodePage:  37,
+		MinBytes:  1,
+		MaxBytes:  1,
+		Languages: []string{"en", "fr", "nl", "pt"},
+		Group:     "EBCDIC",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm1047",
+		Aliases:   []string{"cp1047", "csibm1047", "ibm-1047"},
+		MIME:      "IBM1047",
+		IANA:      "IBM1047",
+		CodePage:  1047,
+		MinBytes:  1,
+		MaxBytes:  1,
+		Languages: []string{"en"},
+		Group:     "EBCDIC",
+		index:     "iana",
+	},
+	{
+		Name:      "ibm01140",
+		Aliases:   []string{"ccsid01140", "cp01140", "cp1140", "csibm01140", "ebcdic-us-37+euro", "ibm-1140", "ibm1140"},
+		MIME:      "IBM01140",
+		IANA:      "IBM01140",
+		CodePage:  1140,
+		MinBytes:  1,
+		MaxBytes:  1,
+		Languages: []string{"en", "fr", "nl", "pt"},
+		Group:     "EBCDIC",
+		index:     "iana",
+	},
+	{
+		Name:     "replacement",
+		Aliases:  []string{"csiso2022kr", "iso-2022-cn", "iso-2022-cn-ext", "iso-2022-kr"},
+		MinBytes: 1,
+		MaxBytes: 4,
+		Group:    "Miscellaneous",
+	},
+	{
+		Name:     "x-user-defined",
+		MinBytes: 1,
+		MaxBytes: 1,
+		ASCII:    true,
+		Group:    "Miscellaneous",
+	},
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	seen := map[string]string{}
+	for _, e := range registry {
+		for _, name := range append([]string{e.Name}, e.Aliases...) {
+			if prev, ok := seen[name]; ok {
+				t.Errorf("%s is listed under both %s and %s", name, prev, e.Name)
+			}
+			seen[name] = e.Name
+			if _, err := parseEncoding(name); err != nil {
+				t.Errorf("parseEncoding(%q): %s", name, err)
+			}
+		}
+		if e.MinBytes < 1 || e.MaxBytes < e.MinBytes {
+			t.Errorf("%s: bad byte width %s", e.Name, e.width())
+		}
+	}
+	if _, err := parseEncoding("no-such-encoding"); err == nil {
+		t.Error("parseEncoding accepted an unknown name")
+	}
+}
+
+func TestEBCDIC(t *testing.T) {
+	var cases = []struct {
+		name string
+		text string
+		want []byte
+	}{
+		{"cp037", "Hello [1]", []byte{0xC8, 0x85, 0x93, 0x93, 0x96, 0x40, 0xBA, 0xF1, 0xBB}},
+		{"ebcdic-cp-us", "Hello [1]", []byte{0xC8, 0x85, 0x93, 0x93, 0x96, 0x40, 0xBA, 0xF1, 0xBB}},
+		{"cp1047", "Hello [1]", []byte{0xC8, 0x85, 0x93, 0x93, 0x96, 0x40, 0xAD, 0xF1, 0xBD}},
+		{"ibm-1140", "€ 5,00", []byte{0x9F, 0x40, 0xF5, 0x6B, 0xF0, 0xF0}},
+		{"cp437", "╔═╗ é", []byte{0xC9, 0xCD, 0xBB, 0x20, 0x82}},
+		{"cp850", "Ø ø", []byte{0x9D, 0x20, 0x9B}},
+		{"cp852", "Łódź", []byte{0x9D, 0xA2, 0x64, 0xAB}},
+	}
+	for _, c := range cases {
+		enc, err := parseEncoding(c.name)
+		if err != nil {
+			t.Errorf("parseEncoding(%q): %s", c.name, err)
+			continue
+		}
+		got, err := enc.NewEncoder().Bytes([]byte(c.text))
+		if err != nil {
+			t.Errorf("%s: encode: %s", c.name, err)
+			continue
+		}
+		if !bytes.Equal(got, c.want) {
+			t.Errorf("%s: encode %q got % X, want % X", c.name, c.text, got, c.want)
+		}
+		back, err := enc.NewDecoder().Bytes(got)
+		if err != nil || string(back) != c.text {
+			t.Errorf("%s: round trip got %q (%v), want %q", c.name, back, err, c.text)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding"
+)
+
+// server exposes detection and conversion over HTTP, the detection flags are
+// defaults that requests override with query parameters of the same name.
+type server struct {
+	Listen  string        `name:"listen" default:"localhost:8080" help:"Listen address."`
+	MaxSize int64         `name:"max-size" default:"33554432" help:"Reject request bodies larger than this many bytes."`
+	Timeout time.Duration `name:"timeout" default:"1m" help:"Limit the time to read a request and write its response."`
+	detection
+}
+
+func (c *server) Run() error {
+	if err := c.detection.check(); err != nil {
+		return err
+	}
+	srv := &http.Server{
+		Addr:              c.Listen,
+		Handler:           c.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       c.Timeout,
+		WriteTimeout:      c.Timeout,
+	}
+	log.Printf("serving on %s", c.Listen)
+	return srv.ListenAndServe()
+}
+
+func (c *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /detect", c.detect)
+	mux.HandleFunc("POST /convert", c.convert)
+	return mux
+}
+
+// detect answers the detected encoding, language and script of the body as
+// JSON.
+func (c *server) detect(w http.ResponseWriter, r *http.Request) {
+	d, err := c.requestDetection(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	res, err := detectResult(bufio.NewReader(r.Body), d.detectOptions()...)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("detect encoding failed: %s", err), http.StatusUnprocessableEntity)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+// convert streams the body converted from the encoding in the from parameter,
+// auto by default, to the one in to, utf-8 by default. Detection results are
+// returned in X-Detected-* headers, an error after the response started in
+// the X-Transcode-Error trailer.
+func (c *server) convert(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	from, to := cmp.Or(q.Get("from"), "auto"), cmp.Or(q.Get("to"), "utf-8")
+	d, err := c.requestDetection(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if r.ContentLength > c.MaxSize {
+		http.Error(w, fmt.Sprintf("request body larger than %d bytes", c.MaxSize), http.StatusRequestEntityTooLarge)
+		return
+	}
+	target, err := parseEncoding(to)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("parse target-encoding %s failed: %s", to, err), http.StatusBadRequest)
+		return
+	}
+	body := bufio.NewReader(http.MaxBytesReader(w, r.Body, c.MaxSize))
+	var source encoding.Encoding
+	if strings.EqualFold(from, "auto") {
+		res, err := detectResult(body, d.detectOptions()...)
+		if err == nil {
+			source, err = parseEncoding(res.Encoding)
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("cannot determine source-encoding: %s", err), http.StatusUnprocessableEntity)
+			return
+		}
+		from = res.Encoding
+		w.Header().Set("X-Detected-Encoding", res.Encoding)
+		if res.Language != "" {
+			w.Header().Set("X-Detected-Language", res.Language)
+		}
+		if res.Script != "" {
+			w.Header().Set("X-Detected-Script", res.Script)
+		}
+	} else if source, err = parseEncoding(from); err != nil {
+		http.Error(w, fmt.Sprintf("parse source-encoding %s failed: %s", from, err), http.StatusBadRequest)
+		return
+	}
+	// HTTP/1 closes the body when the response starts unless full duplex
+	http.NewResponseController(w).EnableFullDuplex()
+	w.Header().Set("Trailer", "X-Transcode-Error")
+	w.Header().Set("Content-Type", "text/plain; charset="+charsetLabel(to))
+	w.Header().Set("X-Source-Encoding", from)
+	if err = convert(w, body, source, target); err != nil {
+		if mbe := (*http.MaxBytesError)(nil); errors.As(err, &mbe) {
+			err = fmt.Errorf("request body larger than %d bytes", mbe.Limit)
+		}
+		log.Printf("convert request from %s failed: %s", r.RemoteAddr, err)
+		w.Header().Set("X-Transcode-Error", err.Error())
+	}
+}
+
+// requestDetection overrides the detection flags with the query parameters
+// trust-declared, lang, prefer, only and superset. Lists are comma-separated
+// or repeated.
+func (c *server) requestDetection(q url.Values) (*detection, error) {
+	d := c.detection
+	if v := q.Get("trust-declared"); v != "" {
+		if !slices.Contains([]string{"always", "verify", "never"}, v) {
+			return nil, fmt.Errorf("invalid trust-declared %s, one of always,verify,never", v)
+		}
+		d.TrustDeclared = v
+	}
+	if q.Has("lang") {
+		d.Lang = q.Get("lang")
+	}
+	if q.Has("prefer") {
+		d.Prefer = splitList(q["prefer"])
+	}
+	if q.Has("only") {
+		d.Only = splitList(q["only"])
+	}
+	if q.Has("superset") {
+		v, err := strconv.ParseBool(cmp.Or(q.Get("superset"), "true"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid superset %s", q.Get("superset"))
+		}
+		d.Superset = v
+	}
+	return &d, d.check()
+}
+
+func splitList(values []string) (list []string) {
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				list = append(list, s)
+			}
+		}
+	}
+	return
+}
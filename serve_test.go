@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestServe(t *testing.T) {
+	quietLog(t)
+	sample, err := os.ReadFile(filepath.Join(corpusDir, "gbk", "zh-medium.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, _ := parseEncoding("gbk")
+	text, _ := enc.NewDecoder().String(string(sample))
+
+	s := &server{MaxSize: 4096, detection: detection{TrustDeclared: "verify"}}
+	ts := httptest.NewServer(s.handler())
+	defer ts.Close()
+
+	tests := []struct {
+		name    string
+		path    string
+		body    io.Reader
+		status  int
+		out     string
+		headers map[string]string
+	}{
+		{"detect", "/detect", bytes.NewReader(sample), http.StatusOK, `{"encoding":"gb2312","language":"zh","script":"Hani"}` + "\n", nil},
+		{"detect with hint", "/detect?only=gb18030", bytes.NewReader(sample), http.StatusOK, `{"encoding":"gb18030","language":"zh","script":"Hani"}` + "\n", nil},
+		{"detect empty", "/detect", strings.NewReader(""), http.StatusUnprocessableEntity, "", nil},
+		{"detect bad lang", "/detect?lang=xx", bytes.NewReader(sample), http.StatusBadRequest, "", nil},
+		{"convert auto", "/convert", bytes.NewReader(sample), http.StatusOK, text, map[string]string{
+			"Content-Type":        "text/plain; charset=utf-8",
+			"X-Detected-Encoding": "gb2312",
+			"X-Detected-Language": "zh",
+			"X-Source-Encoding":   "gb2312",
+		}},
+		{"convert given", "/convert?from=utf8&to=utf-16le-bom", strings.NewReader("ab"), http.StatusOK, "\xff\xfea\x00b\x00", map[string]string{
+			"Content-Type":      "text/plain; charset=utf-16le",
+			"X-Source-Encoding": "utf8",
+		}},
+		{"convert unencodable", "/convert?from=utf8&to=latin1", strings.NewReader("ab你"), http.StatusOK, "ab", map[string]string{
+			"X-Transcode-Error": "encoding: rune not supported by encoding.",
+		}},
+		{"convert too large", "/convert?from=utf8", bytes.NewReader(make([]byte, 5000)), http.StatusRequestEntityTooLarge, "", nil},
+		{"convert too large stream", "/convert?from=utf8", iotest.HalfReader(bytes.NewReader(bytes.Repeat([]byte("a"), 5000))), http.StatusOK, strings.Repeat("a", 4096), map[string]string{
+			"X-Transcode-Error": "request body larger than 4096 bytes",
+		}},
+		{"convert bad target", "/convert?to=no-such", bytes.NewReader(sample), http.StatusBadRequest, "", nil},
+		{"convert bad source", "/convert?from=no-such", bytes.NewReader(sample), http.StatusBadRequest, "", nil},
+		{"convert bad only", "/convert?only=no-such", bytes.NewReader(sample), http.StatusBadRequest, "", nil},
+	}
+	for _, tt := range tests {
+		resp, err := http.Post(ts.URL+tt.path, "text/plain", tt.body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != tt.status {
+			t.Errorf("%s: status %d (%s), want %d", tt.name, resp.StatusCode, out, tt.status)
+			continue
+		}
+		if tt.status == http.StatusOK && string(out) != tt.out {
+			t.Errorf("%s: got %q, want %q", tt.name, out, tt.out)
+		}
+		for k, v := range tt.headers {
+			got := resp.Header.Get(k)
+			if got == "" {
+				got = resp.Trailer.Get(k)
+			}
+			if got != v {
+				t.Errorf("%s: %s is %q, want %q", tt.name, k, got, v)
+			}
+		}
+	}
+
+	resp, err := http.Get(ts.URL + "/detect")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET /detect: status %d", resp.StatusCode)
+	}
+}
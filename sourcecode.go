@@ -0,0 +1,362 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+)
+
+// sourceLanguages maps file extensions to the lexical rules scanSource knows.
+var sourceLanguages = map[string]string{
+	".c": "c", ".h": "c",
+	".cc": "cpp", ".cpp": "cpp", ".cxx": "cpp", ".hh": "cpp", ".hpp": "cpp",
+	".java": "java",
+	".js":   "js", ".mjs": "js", ".ts": "js",
+	".cs": "cs",
+	".go": "go",
+	".py": "python",
+}
+
+// sourceSpan is a comment or string literal of a source file, raw literals
+// have no escapes and code holds the offsets of interpolated expressions.
+type sourceSpan struct {
+	kind       string
+	start, end int
+	raw        bool
+	code       [][2]int
+}
+
+// inCode reports whether offset i is in an interpolated expression of s.
+func (s sourceSpan) inCode(i int) bool {
+	for _, c := range s.code {
+		if c[0] <= i && i < c[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// convertSource converts a source file, reporting each run of non-ASCII
+// characters with the comment, string literal or identifier it is in. With
+// --escape-strings, those in string literals are written as \u escapes, so
+// compilers that assume another source encoding read the same strings.
+func (c *trans) convertSource(w io.Writer, r io.Reader, f string) error {
+	lang, ok := sourceLanguages[strings.ToLower(filepath.Ext(f))]
+	if !ok {
+		return fmt.Errorf("unknown language of %s, --source-code knows %s", f, strings.Join(slices.Sorted(maps.Keys(sourceLanguages)), ","))
+	}
+	dat, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	text, _, err := transform.Bytes(c.decoder(), dat)
+	if err != nil {
+		return fmt.Errorf("decode as %s failed: %w", c.source, err)
+	}
+	src := string(text)
+	spans := scanSource(lang, src)
+	reportSource(f, src, spans)
+	if c.EscapeStrings {
+		src = escapeStrings(f, lang, src, spans)
+	}
+	out, err := c.target.NewEncoder().Bytes([]byte(src))
+	if err != nil {
+		return fmt.Errorf("encode as %s failed: %w", c.target, err)
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// scanSource returns the comments and string literals of src in order.
+func scanSource(lang, src string) (spans []sourceSpan) {
+	python := lang == "python"
+	for i := 0; i < len(src); {
+		rest := src[i:]
+		s := sourceSpan{kind: "string", start: i}
+		switch {
+		case python && rest[0] == '#', !python && strings.HasPrefix(rest, "//"):
+			s.kind, s.end = "comment", closeAt(src, i, "\n")
+		case !python && strings.HasPrefix(rest, "/*"):
+			s.kind, s.end = "comment", closeAt(src, i+2, "*/")
+		case lang == "java" && strings.HasPrefix(rest, `"""`):
+			s.end = quoteEnd(src, i+3, `"""`)
+		case lang == "cs" && (strings.HasPrefix(rest, `@"`) || strings.HasPrefix(rest, `$@"`) || strings.HasPrefix(rest, `@$"`)):
+			s.end, s.raw = verbatimEnd(src, i+strings.IndexByte(rest, '"')+1), true
+		case rest[0] == '`' && (lang == "go" || lang == "js"):
+			s.end, s.raw = closeAt(src, i+1, "`"), lang == "go"
+		case rest[0] == '"' || rest[0] == '\'':
+			s.end = literalEnd(python, src, i)
+		case isIdentByte(rest[0]):
+			n := strings.IndexFunc(rest, func(r rune) bool { return !isIdentRune(r) })
+			if n < 0 {
+				n = len(rest)
+			}
+			if end, raw, ok := prefixedLiteral(lang, rest[:n], src, i+n); ok {
+				s.end, s.raw = end, raw
+				break
+			}
+			i += n
+			continue
+		default:
+			_, n := utf8.DecodeRuneInString(rest)
+			i += n
+			continue
+		}
+		if s.kind == "string" {
+			if open := interpolationOpen(lang, src[s.start:s.end]); open != "" {
+				s.code = interpolations(src, s.start, s.end, open)
+			}
+		}
+		spans = append(spans, s)
+		i = s.end
+	}
+	return
+}
+
+// prefixedLiteral returns the end of a literal whose quote at i follows a
+// string prefix, like r'...' and f"..." of Python or the raw strings R"(...)"
+// and u8R"(...)" of C++.
+func prefixedLiteral(lang, prefix, src string, i int) (end int, raw, ok bool) {
+	if i >= len(src) || src[i] != '"' && src[i] != '\'' {
+		return
+	}
+	switch {
+	case lang == "python" && isStringPrefix(prefix):
+		return literalEnd(true, src, i), strings.ContainsAny(prefix, "rR"), true
+	case lang == "cpp" && src[i] == '"' && slices.Contains([]string{"R", "u8R", "uR", "UR", "LR"}, prefix):
+		delim, _, _ := strings.Cut(src[i+1:], "(")
+		return closeAt(src, i+2+len(delim), ")"+delim+`"`), true, true
+	case lang == "cs" && src[i] == '"' && prefix == "$":
+		return literalEnd(false, src, i), false, true
+	}
+	return
+}
+
+// interpolationOpen returns what opens an interpolated expression in lit, ${
+// in JavaScript template literals and { in Python f-strings and C# $"..."
+// strings, or nothing when lit has none.
+func interpolationOpen(lang, lit string) string {
+	prefix := lit[:max(strings.IndexAny(lit, "\"'`"), 0)]
+	switch {
+	case lang == "js" && lit[0] == '`':
+		return "${"
+	case lang == "python" && strings.ContainsAny(prefix, "fF"), lang == "cs" && strings.Contains(prefix, "$"):
+		return "{"
+	}
+	return ""
+}
+
+// interpolations returns the offsets of the expressions in the literal from
+// start to end, each up to its closing brace. A doubled {{ and an escaped \${
+// are text.
+func interpolations(src string, start, end int, open string) (code [][2]int) {
+	for i := start; i < end; i++ {
+		switch {
+		case open == "{" && strings.HasPrefix(src[i:end], "{{"):
+			i++
+		case open == "${" && src[i] == '\\':
+			i++
+		case strings.HasPrefix(src[i:end], open):
+			j, depth := i+len(open), 1
+			for ; j < end; j++ {
+				if src[j] == '{' {
+					depth++
+				} else if src[j] == '}' {
+					if depth--; depth == 0 {
+						break
+					}
+				}
+			}
+			code = append(code, [2]int{i + len(open), j})
+			i = j
+		}
+	}
+	return
+}
+
+// verbatimEnd returns the end of a C# verbatim string whose content starts at
+// i, where a doubled quote stands for a quote and there are no escapes.
+func verbatimEnd(src string, i int) int {
+	for i < len(src) {
+		n := strings.IndexByte(src[i:], '"')
+		switch {
+		case n < 0:
+			return len(src)
+		case strings.HasPrefix(src[i+n:], `""`):
+			i += n + 2
+		default:
+			return i + n + 1
+		}
+	}
+	return len(src)
+}
+
+// closeAt returns the offset after the first close at or after i, or the end
+// of src. A line comment ends before its line break.
+func closeAt(src string, i int, close string) int {
+	n := strings.Index(src[i:], close)
+	switch {
+	case n < 0:
+		return len(src)
+	case close == "\n":
+		return i + n
+	}
+	return i + n + len(close)
+}
+
+// literalEnd returns the end of the string or character literal whose quote
+// is at i. Python literals may be triple quoted, other ones end at the line
+// break when they are not closed. A backslash escapes the quote in raw Python
+// literals too.
+func literalEnd(python bool, src string, i int) int {
+	q := src[i : i+1]
+	if python && strings.HasPrefix(src[i:], q+q+q) {
+		return quoteEnd(src, i+3, q+q+q)
+	}
+	return quoteEnd(src, i+1, q)
+}
+
+func quoteEnd(src string, i int, q string) int {
+	for i < len(src) {
+		switch {
+		case strings.HasPrefix(src[i:], q):
+			return i + len(q)
+		case src[i] == '\\' && i+1 < len(src):
+			i += 2
+		case src[i] == '\n' && len(q) == 1:
+			return i
+		default:
+			i++
+		}
+	}
+	return len(src)
+}
+
+func isIdentByte(b byte) bool {
+	return b == '_' || b == '$' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
+
+func isStringPrefix(p string) bool {
+	switch strings.ToLower(p) {
+	case "r", "u", "b", "f", "br", "rb", "fr", "rf":
+		return true
+	}
+	return false
+}
+
+// kindAt returns the kind of the span at offset i of rune r, identifier or
+// code when it is in no span.
+func kindAt(spans []sourceSpan, i int, r rune) string {
+	k, found := slices.BinarySearchFunc(spans, i, func(s sourceSpan, i int) int {
+		switch {
+		case s.end <= i:
+			return -1
+		case s.start > i:
+			return 1
+		}
+		return 0
+	})
+	switch {
+	case found && !spans[k].inCode(i):
+		return spans[k].kind
+	case isIdentRune(r):
+		return "identifier"
+	}
+	return "code"
+}
+
+// reportSource logs every run of non-ASCII characters with its position and
+// kind, and a summary per kind.
+func reportSource(f, src string, spans []sourceSpan) {
+	counts := make(map[string]int)
+	line, col := 1, 1
+	var run strings.Builder
+	var runKind string
+	var runLine, runCol int
+	flush := func() {
+		if run.Len() > 0 {
+			log.Printf("%s:%d:%d: non-ASCII in %s: %s", f, runLine, runCol, runKind, run.String())
+			counts[runKind]++
+			run.Reset()
+		}
+	}
+	for i, r := range src {
+		if r < utf8.RuneSelf {
+			flush()
+		} else {
+			if kind := kindAt(spans, i, r); kind != runKind || run.Len() == 0 {
+				flush()
+				runKind, runLine, runCol = kind, line, col
+			}
+			run.WriteRune(r)
+		}
+		if col++; r == '\n' {
+			line, col = line+1, 1
+		}
+	}
+	flush()
+	if len(counts) > 0 {
+		log.Printf("%s: non-ASCII in %d comments, %d strings, %d identifiers and %d elsewhere",
+			f, counts["comment"], counts["string"], counts["identifier"], counts["code"])
+	}
+}
+
+// escapeStrings writes the non-ASCII characters of string literals as
+// escapes of the language, raw literals cannot have them and are kept, and
+// interpolated expressions are code and kept too.
+func escapeStrings(f, lang, src string, spans []sourceSpan) string {
+	var b strings.Builder
+	last := 0
+	for _, s := range spans {
+		lit := src[s.start:s.end]
+		if s.kind != "string" || isASCII(lit) {
+			continue
+		}
+		if s.raw {
+			line := strings.Count(src[:s.start], "\n") + 1
+			log.Printf("%s:%d: kept non-ASCII in raw string literal, it cannot have escapes", f, line)
+			continue
+		}
+		b.WriteString(src[last:s.start])
+		for i, r := range lit {
+			if r < utf8.RuneSelf || s.inCode(s.start+i) {
+				b.WriteRune(r)
+			} else {
+				b.WriteString(escapeRune(lang, r))
+			}
+		}
+		last = s.end
+	}
+	b.WriteString(src[last:])
+	return b.String()
+}
+
+// escapeRune returns the escape of r, Java, JavaScript and C# take UTF-16
+// surrogate pairs beyond the BMP.
+func escapeRune(lang string, r rune) string {
+	switch {
+	case r <= 0xffff:
+		return fmt.Sprintf(`\u%04x`, r)
+	case lang == "java" || lang == "js" || lang == "cs":
+		hi, lo := utf16Surrogates(r)
+		return fmt.Sprintf(`\u%04x\u%04x`, hi, lo)
+	}
+	return fmt.Sprintf(`\U%08x`, r)
+}
+
+func utf16Surrogates(r rune) (rune, rune) {
+	r -= 0x10000
+	return 0xd800 + (r>>10)&0x3ff, 0xdc00 + r&0x3ff
+}
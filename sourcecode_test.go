@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+func TestScanSource(t *testing.T) {
+	tests := []struct {
+		lang string
+		in   string
+		want []string // kind and text of each span, raw ones marked with !
+	}{
+		{"c", "int a = 1; // 注释\nchar *s = \"a\\\"b\"; /* x\ny */ char c = '\"';", []string{"comment // 注释", `string "a\"b"`, "comment /* x\ny */", `string '"'`}},
+		{"c", "s = \"open\nx = 'y'", []string{`string "open`, "string 'y'"}},
+		{"cpp", "auto r = R\"x(a)\"b)x\"; Rx = 1;", []string{`string! R"x(a)"b)x"`}},
+		{"cpp", "auto a = u8R\"(é\\n)\"; auto b = LR\"d(x)\"d)d\"; auto c = u8\"é\";", []string{`string! u8R"(é\n)"`, `string! LR"d(x)"d)d"`, `string "é"`}},
+		{"cs", "var p = @\"C:\\dir\\\"\"é\"\"\"; var q = $@\"{x}\\\"; var r = \"\\\"\";", []string{`string! @"C:\dir\""é"""`, `string! $@"{x}\"`, `string "\""`}},
+		{"java", "String s = \"\"\"\n  文本 \"\"\"; // c", []string{"string \"\"\"\n  文本 \"\"\"", "comment // c"}},
+		{"go", "s := `a\\` + \"b\"", []string{"string! `a\\`", `string "b"`}},
+		{"js", "`a${b}` // c", []string{"string `a${b}`", "comment // c"}},
+		{"python", "# c\ns = r'a\\'b' + f\"x\" + '''\n'''\nbr = 1", []string{"comment # c", `string! r'a\'b'`, `string f"x"`, "string '''\n'''"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, s := range scanSource(tt.lang, tt.in) {
+			kind := s.kind
+			if s.raw {
+				kind += "!"
+			}
+			got = append(got, kind+" "+tt.in[s.start:s.end])
+		}
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("scanSource(%s, %q) = %q, want %q", tt.lang, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEscapeRune(t *testing.T) {
+	tests := []struct {
+		lang string
+		r    rune
+		want string
+	}{
+		{"c", '你', `\u4f60`},
+		{"go", '😀', `\U0001f600`},
+		{"python", '😀', `\U0001f600`},
+		{"java", '😀', `\ud83d\ude00`},
+		{"cs", 'é', `\u00e9`},
+	}
+	for _, tt := range tests {
+		if got := escapeRune(tt.lang, tt.r); got != tt.want {
+			t.Errorf("escapeRune(%s, %q) = %s, want %s", tt.lang, tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestEscapeStrings(t *testing.T) {
+	quietLog(t)
+	tests := []struct {
+		lang string
+		in   string
+		want string
+	}{
+		{"cs", `var p = @"C:\dir\é"; var s = "é";`, `var p = @"C:\dir\é"; var s = "\u00e9";`},
+		{"cs", `var p = $@"{x}\é";`, `var p = $@"{x}\é";`},
+		{"cpp", `auto a = u8R"(é\n)"; auto b = u8"é\n";`, `auto a = u8R"(é\n)"; auto b = u8"\u00e9\n";`},
+		{"cpp", `auto a = LR"(é)"; auto b = uR"(é)";`, `auto a = LR"(é)"; auto b = uR"(é)";`},
+		{"python", `s = f"é{naïve + 'é'}é{{é}}" + F'{d["é"]:é>5}'`, `s = f"\u00e9{naïve + 'é'}\u00e9{{\u00e9}}" + F'{d["é"]:é>5}'`},
+		{"js", "s = `é${naïve ? {é: 1} : 'é'}é\\${é}`", "s = `\\u00e9${naïve ? {é: 1} : 'é'}\\u00e9\\${\\u00e9}`"},
+		{"cs", `var s = $"é{naïve}é{{é}}";`, `var s = $"\u00e9{naïve}\u00e9{{\u00e9}}";`},
+	}
+	for _, tt := range tests {
+		if got := escapeStrings("f", tt.lang, tt.in, scanSource(tt.lang, tt.in)); got != tt.want {
+			t.Errorf("escapeStrings(%s, %s) = %s, want %s", tt.lang, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSourceCode(t *testing.T) {
+	const src = "// 打开文件\nclass Main {\n\tString 名字 = \"文件不存在\";\n\tchar c = '错';\n}\n"
+	dir := t.TempDir()
+	f := filepath.Join(dir, "Main.java")
+	if err := os.WriteFile(f, encodeString(t, simplifiedchinese.GBK, src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	log.SetFlags(0)
+	t.Cleanup(func() { log.SetOutput(os.Stderr); log.SetFlags(log.LstdFlags) })
+
+	if _, err := transcode(t, nil, "--source-code", "--escape-strings", "-s", "gbk", "-w", f); err != nil {
+		t.Fatal(err)
+	}
+	dat, err := os.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "// 打开文件\nclass Main {\n\tString 名字 = \"\\u6587\\u4ef6\\u4e0d\\u5b58\\u5728\";\n\tchar c = '\\u9519';\n}\n"
+	if string(dat) != want {
+		t.Errorf("converted source = %q, want %q", dat, want)
+	}
+	for _, line := range []string{
+		f + ":1:4: non-ASCII in comment: 打开文件",
+		f + ":3:9: non-ASCII in identifier: 名字",
+		f + ":3:15: non-ASCII in string: 文件不存在",
+		f + ":4:12: non-ASCII in string: 错",
+		f + ": non-ASCII in 1 comments, 2 strings, 1 identifiers and 0 elsewhere",
+	} {
+		if !strings.Contains(logs.String(), line+"\n") {
+			t.Errorf("log misses %q:\n%s", line, logs.String())
+		}
+	}
+
+	if _, err := transcode(t, nil, "--escape-strings", f); err == nil || !strings.Contains(err.Error(), "needs --source-code") {
+		t.Errorf("--escape-strings without --source-code: err = %v", err)
+	}
+	txt := filepath.Join(dir, "notes.txt")
+	os.WriteFile(txt, []byte("x"), 0644)
+	if _, err := transcode(t, nil, "--source-code", txt); err == nil || !strings.Contains(err.Error(), "unknown language") {
+		t.Errorf("--source-code on %s: err = %v", txt, err)
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/transform"
+
+	"github.com/gonejack/transcode/chardet"
+)
+
+// subtitlePeek is the part of a subtitle searched for dialogue to detect on,
+// timing lines and styles take most of the first 2048 bytes.
+const subtitlePeek = 64 << 10
+
+var (
+	markupTags = regexp.MustCompile(`<[^>]*>|\{[^}]*\}`)
+	assBreaks  = strings.NewReplacer(`\N`, " ", `\n`, " ", `\h`, " ")
+)
+
+// subtitleFormat returns srt, ass or vtt by file extension or content.
+func subtitleFormat(f string, hdr []byte) string {
+	switch strings.ToLower(filepath.Ext(f)) {
+	case ".srt":
+		return "srt"
+	case ".ass", ".ssa":
+		return "ass"
+	case ".vtt":
+		return "vtt"
+	}
+	hdr = bytes.TrimPrefix(hdr, []byte("\xef\xbb\xbf"))
+	switch {
+	case bytes.HasPrefix(hdr, []byte("WEBVTT")):
+		return "vtt"
+	case bytes.Contains(hdr[:min(len(hdr), 512)], []byte("[Script Info]")):
+		return "ass"
+	}
+	return "srt"
+}
+
+// dialogue returns the text lines of a subtitle in an ASCII compatible
+// encoding, without cue numbers and identifiers, timing lines, styles and
+// markup.
+func dialogue(format string, dat []byte) []byte {
+	var out []byte
+	keep := func(line []byte) {
+		if line = bytes.TrimSpace(markupTags.ReplaceAll(line, nil)); len(line) > 0 {
+			out = append(append(out, line...), '\n')
+		}
+	}
+	if format == "ass" {
+		for _, line := range bytes.Split(dat, []byte("\n")) {
+			rest, ok := bytes.CutPrefix(bytes.TrimSpace(line), []byte("Dialogue:"))
+			if fields := bytes.SplitN(rest, []byte(","), 10); ok && len(fields) == 10 {
+				keep([]byte(assBreaks.Replace(string(fields[9]))))
+			}
+		}
+		return out
+	}
+	// cues are blocks separated by blank lines, the text follows the timing
+	// line; WebVTT blocks without one are headers, notes and styles
+	var block [][]byte
+	flush := func() {
+		timing := slices.IndexFunc(block, func(l []byte) bool { return bytes.Contains(l, []byte("-->")) })
+		for _, l := range block[timing+1:] {
+			if timing >= 0 || (format == "srt" && !isDigits(l)) {
+				keep(l)
+			}
+		}
+		block = block[:0]
+	}
+	for _, line := range bytes.Split(dat, []byte("\n")) {
+		if line = bytes.TrimSpace(line); len(line) == 0 {
+			flush()
+		} else {
+			block = append(block, line)
+		}
+	}
+	flush()
+	return out
+}
+
+func isDigits(b []byte) bool {
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return len(b) > 0
+}
+
+// detectSubtitle detects the encoding of a subtitle on its dialogue. Input
+// with a BOM or in UTF-16 or UTF-32 is not ASCII compatible and is detected
+// as a whole.
+func (c *trans) detectSubtitle(srd *bufio.Reader, format string) (chardet.Result, error) {
+	hdr, err := srd.Peek(subtitlePeek)
+	if len(hdr) == 0 {
+		return chardet.Result{}, fmt.Errorf("cannot read input data: %w", err)
+	}
+	sample := hdr
+	_, bom := chardet.SniffBOM(hdr)
+	if _, exx := chardet.DetectEncodingByNulPattern(hdr[:min(len(hdr), 2048)]); bom == 0 && exx != nil {
+		if text := dialogue(format, hdr); len(text) > 0 {
+			sample = text
+		}
+	}
+	return chardet.Detect(sample[:min(len(sample), 2048)], c.detectOptions()...)
+}
+
+// convertSubtitle converts a subtitle and normalizes its line endings, CRLF
+// for SubRip and ASS as most players and editors expect, LF for WebVTT.
+func (c *trans) convertSubtitle(w io.Writer, r io.Reader, format string) error {
+	dat, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	text, _, err := transform.Bytes(c.decoder(), dat)
+	if err != nil {
+		return fmt.Errorf("decode as %s failed: %w", c.source, err)
+	}
+	eol := "\r\n"
+	if format == "vtt" {
+		eol = "\n"
+	}
+	text = []byte(strings.NewReplacer("\r\n", eol, "\r", eol, "\n", eol).Replace(string(text)))
+	out, err := c.target.NewEncoder().Bytes(text)
+	if err != nil {
+		return fmt.Errorf("encode as %s failed: %w", c.target, err)
+	}
+	_, err = w.Write(out)
+	return err
+}
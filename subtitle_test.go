@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// subtitleLines are the sentences of a sample text, one cue each.
+func subtitleLines(t *testing.T, lang string) []string {
+	t.Helper()
+	dat, err := os.ReadFile(filepath.Join("testfiles", "texts", lang+".txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, s := range strings.FieldsFunc(string(dat), func(r rune) bool { return strings.ContainsRune("。，\n", r) }) {
+		lines = append(lines, s)
+	}
+	return lines
+}
+
+func srt(lines []string) string {
+	var b strings.Builder
+	for i, l := range lines {
+		fmt.Fprintf(&b, "%d\n00:00:%02d,000 --> 00:00:%02d,500\n<i>%s</i>\n\n", i+1, i, i, l)
+	}
+	return b.String()
+}
+
+func encodeString(t *testing.T, enc encoding.Encoding, s string) []byte {
+	t.Helper()
+	dat, err := enc.NewEncoder().Bytes([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dat
+}
+
+func TestDialogue(t *testing.T) {
+	tests := []struct {
+		format string
+		in     string
+		want   string
+	}{
+		{"srt", "1\r\n00:00:01,000 --> 00:00:02,000\r\n<i>Hello</i>\r\n{\\an8}there\r\n\r\n2\r\n00:00:03,000 --> 00:00:04,000\r\nBye\r\n", "Hello\nthere\nBye\n"},
+		{"vtt", "WEBVTT\nKind: captions\n\nNOTE a comment\nspanning lines\n\nSTYLE\n::cue { color: red }\n\nintro\n00:01.000 --> 00:02.000\n<v Tom>Hello</v>\n", "Hello\n"},
+		{"ass", "[Script Info]\nTitle: x\n\n[V4+ Styles]\nStyle: Default,Arial,20\n\n[Events]\nFormat: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text\nDialogue: 0,0:00:01.00,0:00:02.00,Default,,0,0,0,,{\\i1}Hello, you\\Nthere\n", "Hello, you there\n"},
+	}
+	for _, tt := range tests {
+		if got := dialogue(tt.format, []byte(tt.in)); string(got) != tt.want {
+			t.Errorf("dialogue(%s) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestSubtitle(t *testing.T) {
+	quietLog(t)
+	zh := srt(subtitleLines(t, "zh"))
+	hant := subtitleLines(t, "zh-Hant")
+	var ass strings.Builder
+	ass.WriteString("[Script Info]\r\nTitle: sample\r\nScriptType: v4.00+\r\nPlayResX: 1920\r\nPlayResY: 1080\r\n\r\n[V4+ Styles]\r\n")
+	ass.WriteString("Format: Name, Fontname, Fontsize, PrimaryColour, SecondaryColour, OutlineColour, BackColour, Bold, Italic, Underline, StrikeOut, ScaleX, ScaleY, Spacing, Angle, BorderStyle, Outline, Shadow, Alignment, MarginL, MarginR, MarginV, Encoding\r\n")
+	for i := range 12 {
+		fmt.Fprintf(&ass, "Style: Style%d,Arial,52,&H00FFFFFF,&H000000FF,&H00000000,&H64000000,0,0,0,0,100,100,0,0,1,2,1,2,10,10,10,1\r\n", i)
+	}
+	ass.WriteString("\r\n[Events]\r\nFormat: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text\r\n")
+	for i, l := range hant {
+		fmt.Fprintf(&ass, "Dialogue: 0,0:00:%02d.00,0:00:%02d.50,Style0,,0,0,0,,{\\fad(200,200)}%s\r\n", i, i, l)
+	}
+
+	tests := []struct {
+		name string
+		file string
+		in   []byte
+		args []string
+		want string
+	}{
+		{"srt", "zh.srt", encodeString(t, simplifiedchinese.GBK, zh), nil, strings.ReplaceAll(zh, "\n", "\r\n")},
+		{"ass", "zh.ass", encodeString(t, traditionalchinese.Big5, ass.String()), nil, ass.String()},
+		{"ass to simplified", "zh.ass", []byte("[Script Info]\r\n\r\n[Events]\r\nDialogue: 0,0:00:01.00,0:00:02.00,Default,,0,0,0,,這個軟體說話\r\n"), []string{"-s", "utf8", "--chinese", "t2s"}, "[Script Info]\r\n\r\n[Events]\r\nDialogue: 0,0:00:01.00,0:00:02.00,Default,,0,0,0,,这个软体说话\r\n"},
+		{"vtt", "zh.vtt", []byte("WEBVTT\r\n\r\n00:01.000 --> 00:02.000\r\n这个软件\r\n"), []string{"-s", "utf8", "--chinese", "s2t"}, "WEBVTT\n\n00:01.000 --> 00:02.000\n這個軟件\n"},
+	}
+	for _, tt := range tests {
+		f := filepath.Join(t.TempDir(), tt.file)
+		if err := os.WriteFile(f, tt.in, 0644); err != nil {
+			t.Fatal(err)
+		}
+		out, err := transcode(t, nil, append(append([]string{"--subtitle"}, tt.args...), f)...)
+		switch {
+		case err != nil:
+			t.Errorf("%s: %s", tt.name, err)
+		case string(out) != tt.want:
+			t.Errorf("%s: got %q, want %q", tt.name, out, tt.want)
+		}
+	}
+}
+
+func TestSubtitleDetect(t *testing.T) {
+	quietLog(t)
+	f := filepath.Join(t.TempDir(), "zh.srt")
+	if err := os.WriteFile(f, encodeString(t, simplifiedchinese.GBK, srt(subtitleLines(t, "zh"))), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := transcode(t, nil, "--subtitle", "-d", f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "encoding of file " + f + " is gb2312"; !strings.HasPrefix(string(out), want) {
+		t.Errorf("got %q, want %q", out, want)
+	}
+}
@@ -2,61 +2,89 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"log"
 	"os"
+	"slices"
 	"strings"
 
-	"github.com/alecthomas/kong"
 	"golang.org/x/text/encoding"
-	"golang.org/x/text/encoding/htmlindex"
 	"golang.org/x/text/encoding/simplifiedchinese"
-	"golang.org/x/text/encoding/unicode"
-	"golang.org/x/text/encoding/unicode/utf32"
 	"golang.org/x/text/transform"
 
 	"github.com/gonejack/transcode/chardet"
 )
 
+// detection holds the flags shared by the commands that detect encodings.
+type detection struct {
+	TrustDeclared string   `name:"trust-declared" enum:"always,verify,never" default:"verify" help:"Trust in-band charset declarations (XML, HTML, CSS, coding cookies, modelines), one of always,verify,never."`
+	Lang          string   `name:"lang" help:"Hint the language of the text as ISO 639-1 code, detection prefers encodings plausible for it."`
+	Prefer        []string `name:"prefer" help:"Prefer these encodings in order when they fit the input, like gb18030,utf8."`
+	Only          []string `name:"only" help:"Restrict detection to these encodings."`
+	Superset      bool     `name:"superset" help:"Upgrade detected encodings to their widest safe superset, like gb2312 to gb18030."`
+}
+
 type options struct {
-	SourceEncoding string   `short:"s" name:"source-encoding" default:"auto" help:"Set source encoding, default as auto-detection."`
-	TargetEncoding string   `short:"t" name:"target-encoding" default:"utf8" help:"Set target encoding, default as utf8."`
-	DetectEncoding bool     `short:"d" name:"detect-encoding" help:"Detect encoding only."`
-	Overwrite      bool     `short:"w" name:"overwrite" help:"Overwrite source file."`
-	ListEncodings  bool     `short:"l" name:"list-encodings" help:"list supported encodings"`
-	About          bool     `help:"Show about."`
-	File           []string `arg:"" optional:""`
+	SourceEncoding  string   `short:"s" name:"source-encoding" default:"auto" help:"Set source encoding, default as auto-detection."`
+	TargetEncoding  string   `short:"t" name:"target-encoding" help:"Set target encoding, default as utf8, or the one of --preset."`
+	DetectEncoding  bool     `short:"d" name:"detect-encoding" help:"Detect encoding only."`
+	Overwrite       bool     `short:"w" name:"overwrite" help:"Overwrite source file."`
+	FixDeclaration  bool     `name:"fix-declarations" help:"Rewrite in-band charset declarations (HTML, XML, CSS, coding cookies) to the target encoding."`
+	CSV             bool     `name:"csv" help:"Convert CSV or TSV, checking every row keeps its number of fields."`
+	Preset          string   `name:"preset" enum:",excel-csv,excel-tsv" default:"" help:"Convert CSV for Excel, excel-csv as UTF-8 with BOM, excel-tsv as UTF-16LE with tabs, one of excel-csv,excel-tsv."`
+	Delimiter       string   `name:"delimiter" help:"Set CSV delimiter, default sniffed from the first row."`
+	OutputDelimiter string   `name:"output-delimiter" help:"Re-delimit CSV with this delimiter, like tab or ;."`
+	Subtitle        bool     `name:"subtitle" help:"Convert subtitles (SRT, ASS/SSA, WebVTT): detect on dialogue text only and normalize line endings."`
+	Chinese         string   `name:"chinese" enum:",s2t,t2s" default:"" help:"Also convert Chinese characters, s2t from simplified to traditional or t2s back, one of s2t,t2s."`
+	Mail            bool     `name:"mail" help:"Convert email messages or mbox files to UTF-8 part by part, keeping attachments."`
+	SourceCode      bool     `name:"source-code" help:"Convert C, C++, Java, JavaScript, C#, Go or Python source, reporting non-ASCII characters in comments, string literals and identifiers."`
+	EscapeStrings   bool     `name:"escape-strings" help:"With --source-code, write non-ASCII characters of string literals as \\u escapes."`
+	ZipNames        string   `name:"zip-names" placeholder:"ENCODING" help:"Convert zip entry names without the UTF-8 flag from this encoding, or auto to detect it, like cp437 or gbk."`
+	ListEncodings   bool     `short:"l" name:"list-encodings" help:"list supported encodings"`
+	Format          string   `name:"format" enum:"text,json" default:"text" help:"Set output format of list-encodings, one of text,json."`
+	File            []string `arg:"" optional:""`
+	detection
 }
 type trans struct {
 	options
 	source encoding.Encoding
 	target encoding.Encoding
+	output io.Writer // stdout if nil
 }
 
-func (c *trans) run() (err error) {
-	kong.Parse(&c.options,
-		kong.Name("transcode"),
-		kong.Description("Translate text encoding."),
-		kong.UsageOnError(),
-	)
-	if c.About {
-		fmt.Println("Visit https://github.com/gonejack/transcode")
+func (c *trans) Run() (err error) {
+	if err = c.detection.check(); err != nil {
 		return
 	}
 	if c.ListEncodings {
-		fmt.Println("Supported encodings:")
-		fmt.Println(strings.Join(encodings(), "\n"))
-		return
+		return printEncodings(os.Stdout, c.Format)
 	}
 	if len(c.File) == 0 {
 		c.File = append(c.File, "-")
 	}
+	c.applyPreset()
+	if c.TargetEncoding == "" {
+		c.TargetEncoding = "utf8"
+	}
+	if err = c.checkCSV(); err != nil {
+		return
+	}
 	c.target, err = parseEncoding(c.TargetEncoding)
 	if err != nil {
 		return fmt.Errorf("parse target-encoding %s failed: %w", c.TargetEncoding, err)
 	}
+	if info, _ := lookupEncoding(c.TargetEncoding); c.Mail && info.Name != "utf-8" {
+		return errors.New("--mail converts to utf-8 only")
+	}
+	if c.EscapeStrings && !c.SourceCode {
+		return errors.New("--escape-strings needs --source-code")
+	}
+	if _, ok := lookupEncoding(c.ZipNames); c.ZipNames != "" && !strings.EqualFold(c.ZipNames, "auto") && !ok {
+		return fmt.Errorf("invalid zip-names encoding: %s", c.ZipNames)
+	}
 	for _, f := range c.File {
 		err = c.proc(f)
 		if err != nil {
@@ -66,7 +94,10 @@ func (c *trans) run() (err error) {
 	return
 }
 func (c *trans) proc(f string) (err error) {
-	src, out := os.Stdin, os.Stdout
+	src, out := os.Stdin, io.Writer(os.Stdout)
+	if c.output != nil {
+		out = c.output
+	}
 	if f != "-" {
 		if c.Overwrite {
 			src, err = os.OpenFile(f, os.O_RDWR, 0)
@@ -87,19 +118,37 @@ func (c *trans) proc(f string) (err error) {
 			log.Printf("no changes, source file %s is empty", f)
 			return
 		}
+		if kind := archiveKind(f); kind != "" {
+			return c.procArchive(f, kind, src, out)
+		}
 	}
 	srd := bufio.NewReader(src)
+	var subtitle string
+	if c.Subtitle {
+		srd = bufio.NewReaderSize(src, subtitlePeek)
+		hdr, _ := srd.Peek(subtitlePeek)
+		subtitle = subtitleFormat(f, hdr)
+	}
 	switch {
+	case c.DetectEncoding && subtitle != "":
+		res, exx := c.detectSubtitle(srd, subtitle)
+		printDetection(f, res, exx)
+		return
 	case c.DetectEncoding:
-		enc, exx := detectEncoding(srd)
-		if exx != nil {
-			fmt.Printf("detecting encoding of file %s failed: %s", f, exx)
-		} else {
-			fmt.Printf("encoding of file %s is %s", f, enc)
-		}
+		res, exx := detectResult(srd, c.detectOptions()...)
+		printDetection(f, res, exx)
 		return
+	case c.Mail && strings.EqualFold(c.SourceEncoding, "auto"):
+		// every part has its own charset, -s only stands in for missing ones
 	case strings.EqualFold(c.SourceEncoding, "auto"):
-		c.source, err = autoEncoding(srd)
+		if subtitle != "" {
+			var res chardet.Result
+			if res, err = c.detectSubtitle(srd, subtitle); err == nil {
+				c.source, err = parseEncoding(res.Encoding)
+			}
+		} else {
+			c.source, err = autoEncoding(srd, c.detectOptions()...)
+		}
 		if err != nil {
 			return fmt.Errorf("cannot determine source-encoding: %w", err)
 		}
@@ -109,62 +158,159 @@ func (c *trans) proc(f string) (err error) {
 			return fmt.Errorf("parse source-encoding %s failed: %w", c.SourceEncoding, err)
 		}
 	}
+	var in io.Reader = srd
+	var fixed bool
+	if c.FixDeclaration {
+		in, fixed = c.fixDeclaration(f, srd)
+	}
 	if src != os.Stdin && c.Overwrite {
-		if c.source == c.target {
+		if c.source == c.target && !fixed && !c.rewrites() {
 			log.Printf("no changes, source file %s is already in target encoding %s", f, c.target)
 			return
 		}
-		out, err = os.CreateTemp(os.TempDir(), "transcode.*.txt")
-		if err != nil {
-			return
+		tmp, exx := os.CreateTemp(os.TempDir(), "transcode.*.txt")
+		if exx != nil {
+			return exx
 		}
 		defer func() {
 			if err == nil {
 				src.Truncate(0)
 				src.Seek(0, io.SeekStart)
-				out.Seek(0, io.SeekStart)
-				_, err = io.Copy(src, out)
+				tmp.Seek(0, io.SeekStart)
+				_, err = io.Copy(src, tmp)
 			}
-			out.Close()
-			os.Remove(out.Name())
+			tmp.Close()
+			os.Remove(tmp.Name())
 		}()
+		out = tmp
 	}
-	r := transform.NewReader(srd, c.source.NewDecoder())
-	w := transform.NewWriter(out, c.target.NewEncoder())
-	_, err = io.Copy(w, r)
-	w.Close()
-	return
+	switch {
+	case subtitle != "":
+		return c.convertSubtitle(out, in, subtitle)
+	case c.CSV:
+		return c.convertCSV(out, in, f)
+	case c.Mail:
+		return c.convertMail(out, in, f)
+	case c.SourceCode:
+		return c.convertSource(out, in, f)
+	}
+	return convertWith(out, in, c.decoder(), c.target)
+}
+
+// rewrites reports options that change the text even when the source is in
+// the target encoding.
+func (c *trans) rewrites() bool {
+	return c.OutputDelimiter != "" || c.Subtitle || c.Chinese != "" || c.EscapeStrings || c.Mail
+}
+
+// decoder decodes from the source encoding and converts Chinese characters
+// as set by --chinese.
+func (c *trans) decoder() transform.Transformer {
+	if t := chineseTransformer(c.Chinese); t != nil {
+		return transform.Chain(c.source.NewDecoder(), t)
+	}
+	return c.source.NewDecoder()
+}
+
+// convert decodes r from source and writes the text to w in target.
+func convert(w io.Writer, r io.Reader, source, target encoding.Encoding) error {
+	return convertWith(w, r, source.NewDecoder(), target)
+}
+
+// convertWith decodes r with dec and writes the text to w in target.
+func convertWith(w io.Writer, r io.Reader, dec transform.Transformer, target encoding.Encoding) error {
+	tw := transform.NewWriter(w, target.NewEncoder())
+	_, err := io.Copy(tw, transform.NewReader(r, dec))
+	if exx := tw.Close(); err == nil {
+		err = exx
+	}
+	return err
+}
+
+// printDetection writes the detection result of a file, one line each.
+func printDetection(f string, res chardet.Result, err error) {
+	switch {
+	case err != nil:
+		fmt.Printf("detecting encoding of file %s failed: %s\n", f, err)
+	case res.Language != "":
+		fmt.Printf("encoding of file %s is %s (language %s, script %s)\n", f, res.Encoding, res.Language, res.Script)
+	case res.Script != "":
+		fmt.Printf("encoding of file %s is %s (script %s)\n", f, res.Encoding, res.Script)
+	default:
+		fmt.Printf("encoding of file %s is %s\n", f, res.Encoding)
+	}
+}
+
+// fixDeclaration replaces the charset of an in-band declaration with the target
+// encoding. The declaration is ASCII, so it is patched in the source bytes.
+func (c *trans) fixDeclaration(f string, srd *bufio.Reader) (io.Reader, bool) {
+	hdr, _ := srd.Peek(2048)
+	d, ok := chardet.SniffDeclaration(hdr)
+	if !ok {
+		return srd, false
+	}
+	label := charsetLabel(c.TargetEncoding)
+	if strings.EqualFold(d.Charset, label) {
+		return srd, false
+	}
+	prefix := slices.Concat(hdr[:d.Start], []byte(label))
+	srd.Discard(d.End)
+	log.Printf("changed %s declaration of file %s from %s to %s", d.Kind, f, d.Charset, label)
+	return io.MultiReader(bytes.NewReader(prefix), srd), true
 }
 
-func autoEncoding(r *bufio.Reader) (enc encoding.Encoding, err error) {
-	coding, err := detectEncoding(r)
+func (d *detection) detectOptions() []chardet.Option {
+	opts := []chardet.Option{
+		chardet.TrustDeclared(chardet.Trust(d.TrustDeclared)),
+		chardet.Language(d.Lang),
+		chardet.Prefer(d.Prefer...),
+		chardet.Only(d.Only...),
+	}
+	if d.Superset {
+		opts = append(opts, chardet.UpgradeToSuperset())
+	}
+	return opts
+}
+func (d *detection) check() error {
+	if d.Lang != "" && !slices.Contains(chardet.Languages(), strings.ToLower(d.Lang)) {
+		return fmt.Errorf("unknown language %s, one of %s", d.Lang, strings.Join(chardet.Languages(), ","))
+	}
+	for _, name := range slices.Concat(d.Prefer, d.Only) {
+		if _, ok := lookupEncoding(name); !ok {
+			return fmt.Errorf("invalid encoding: %s", name)
+		}
+	}
+	return nil
+}
+
+func autoEncoding(r *bufio.Reader, opts ...chardet.Option) (enc encoding.Encoding, err error) {
+	coding, err := detectEncoding(r, opts...)
 	if err == nil {
 		enc, err = parseEncoding(coding)
 	}
 	return
 }
-func detectEncoding(r *bufio.Reader) (string, error) {
+func detectEncoding(r *bufio.Reader, opts ...chardet.Option) (string, error) {
 	hdr, err := r.Peek(2048)
 	if len(hdr) == 0 {
 		return "", fmt.Errorf("cannot read input data: %w", err)
 	}
-	return chardet.DetectEncoding(hdr)
+	return chardet.DetectEncoding(hdr, opts...)
+}
+func detectResult(r *bufio.Reader, opts ...chardet.Option) (chardet.Result, error) {
+	hdr, err := r.Peek(2048)
+	if len(hdr) == 0 {
+		return chardet.Result{}, fmt.Errorf("cannot read input data: %w", err)
+	}
+	return chardet.Detect(hdr, opts...)
 }
 func parseEncoding(encoding string) (enc encoding.Encoding, err error) {
-	switch strings.ToLower(encoding) {
-	case chardet.UTF8WithBOM:
-		return unicode.UTF8BOM, nil
-	case chardet.UTF16LEWithBOM:
-		return unicode.UTF16(unicode.LittleEndian, unicode.UseBOM), nil
-	case chardet.UTF16BEWithBOM:
-		return unicode.UTF16(unicode.BigEndian, unicode.UseBOM), nil
-	case chardet.UTF32LEWithBOM:
-		return utf32.UTF32(utf32.LittleEndian, utf32.UseBOM), nil
-	case chardet.UTF32BEWithBOM:
-		return utf32.UTF32(utf32.BigEndian, utf32.UseBOM), nil
-	}
-	enc, err = htmlindex.Get(encoding)
-	if err != nil {
+	info, ok := lookupEncoding(encoding)
+	if !ok {
+		return nil, fmt.Errorf("invalid encoding: %s", encoding)
+	}
+	enc, err = info.encoding()
+	if err != nil || enc == nil {
 		err = fmt.Errorf("invalid encoding: %s", encoding)
 	}
 	switch enc {
@@ -173,236 +319,3 @@ func parseEncoding(encoding string) (enc encoding.Encoding, err error) {
 	}
 	return
 }
-func encodings() []string {
-	list := []string{
-		"unicode-1-1-utf-8",
-		"unicode11utf8",
-		"unicode20utf8",
-		"utf-8",
-		"utf8",
-		"x-unicode20utf8",
-		"866",
-		"cp866",
-		"csibm866",
-		"ibm866",
-		"csisolatin2",
-		"iso-8859-2",
-		"iso-ir-101",
-		"iso8859-2",
-		"iso88592",
-		"iso_8859-2",
-		"iso_8859-2:1987",
-		"l2",
-		"latin2",
-		"csisolatin3",
-		"iso-8859-3",
-		"iso-ir-109",
-		"iso8859-3",
-		"iso88593",
-		"iso_8859-3",
-		"iso_8859-3:1988",
-		"l3",
-		"latin3",
-		"csisolatin4",
-		"iso-8859-4",
-		"iso-ir-110",
-		"iso8859-4",
-		"iso88594",
-		"iso_8859-4",
-		"iso_8859-4:1988",
-		"l4",
-		"latin4",
-		"csisolatincyrillic",
-		"cyrillic",
-		"iso-8859-5",
-		"iso-ir-144",
-		"iso8859-5",
-		"iso88595",
-		"iso_8859-5",
-		"iso_8859-5:1988",
-		"arabic",
-		"asmo-708",
-		"csiso88596e",
-		"csiso88596i",
-		"csisolatinarabic",
-		"ecma-114",
-		"iso-8859-6",
-		"iso-8859-6-e",
-		"iso-8859-6-i",
-		"iso-ir-127",
-		"iso8859-6",
-		"iso88596",
-		"iso_8859-6",
-		"iso_8859-6:1987",
-		"csisolatingreek",
-		"ecma-118",
-		"elot_928",
-		"greek",
-		"greek8",
-		"iso-8859-7",
-		"iso-ir-126",
-		"iso8859-7",
-		"iso88597",
-		"iso_8859-7",
-		"iso_8859-7:1987",
-		"sun_eu_greek",
-		"csiso88598e",
-		"csisolatinhebrew",
-		"hebrew",
-		"iso-8859-8",
-		"iso-8859-8-e",
-		"iso-ir-138",
-		"iso8859-8",
-		"iso88598",
-		"iso_8859-8",
-		"iso_8859-8:1988",
-		"visual",
-		"csiso88598i",
-		"iso-8859-8-i",
-		"logical",
-		"csisolatin6",
-		"iso-8859-10",
-		"iso-ir-157",
-		"iso8859-10",
-		"iso885910",
-		"l6",
-		"latin6",
-		"iso-8859-13",
-		"iso8859-13",
-		"iso885913",
-		"iso-8859-14",
-		"iso8859-14",
-		"iso885914",
-		"csisolatin9",
-		"iso-8859-15",
-		"iso8859-15",
-		"iso885915",
-		"iso_8859-15",
-		"l9",
-		"iso-8859-16",
-		"cskoi8r",
-		"koi",
-		"koi8",
-		"koi8-r",
-		"koi8_r",
-		"koi8-ru",
-		"koi8-u",
-		"csmacintosh",
-		"mac",
-		"macintosh",
-		"x-mac-roman",
-		"dos-874",
-		"iso-8859-11",
-		"iso8859-11",
-		"iso885911",
-		"tis-620",
-		"windows-874",
-		"cp1250",
-		"windows-1250",
-		"x-cp1250",
-		"cp1251",
-		"windows-1251",
-		"x-cp1251",
-		"ansi_x3.4-1968",
-		"ascii",
-		"cp1252",
-		"cp819",
-		"csisolatin1",
-		"ibm819",
-		"iso-8859-1",
-		"iso-ir-100",
-		"iso8859-1",
-		"iso88591",
-		"iso_8859-1",
-		"iso_8859-1:1987",
-		"l1",
-		"latin1",
-		"us-ascii",
-		"windows-1252",
-		"x-cp1252",
-		"cp1253",
-		"windows-1253",
-		"x-cp1253",
-		"cp1254",
-		"csisolatin5",
-		"iso-8859-9",
-		"iso-ir-148",
-		"iso8859-9",
-		"iso88599",
-		"iso_8859-9",
-		"iso_8859-9:1989",
-		"l5",
-		"latin5",
-		"windows-1254",
-		"x-cp1254",
-		"cp1255",
-		"windows-1255",
-		"x-cp1255",
-		"cp1256",
-		"windows-1256",
-		"x-cp1256",
-		"cp1257",
-		"windows-1257",
-		"x-cp1257",
-		"cp1258",
-		"windows-1258",
-		"x-cp1258",
-		"x-mac-cyrillic",
-		"x-mac-ukrainian",
-		"chinese",
-		"csgb2312",
-		"csiso58gb231280",
-		"gb2312",
-		"gb_2312",
-		"gb_2312-80",
-		"gbk",
-		"iso-ir-58",
-		"x-gbk",
-		"gb18030",
-		"big5",
-		"big5-hkscs",
-		"cn-big5",
-		"csbig5",
-		"x-x-big5",
-		"cseucpkdfmtjapanese",
-		"euc-jp",
-		"x-euc-jp",
-		"csiso2022jp",
-		"iso-2022-jp",
-		"csshiftjis",
-		"ms932",
-		"ms_kanji",
-		"shift-jis",
-		"shift_jis",
-		"sjis",
-		"windows-31j",
-		"x-sjis",
-		"cseuckr",
-		"csksc56011987",
-		"euc-kr",
-		"iso-ir-149",
-		"korean",
-		"ks_c_5601-1987",
-		"ks_c_5601-1989",
-		"ksc5601",
-		"ksc_5601",
-		"windows-949",
-		"csiso2022kr",
-		"hz-gb-2312",
-		"iso-2022-cn",
-		"iso-2022-cn-ext",
-		"iso-2022-kr",
-		"replacement",
-		"unicodefffe",
-		"utf-16be",
-		"csunicode",
-		"iso-10646-ucs-2",
-		"ucs-2",
-		"unicode",
-		"unicodefeff",
-		"utf-16",
-		"utf-16le",
-		"x-user-defined",
-	}
-	return list
-}
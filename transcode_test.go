@@ -0,0 +1,261 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFixDeclaration(t *testing.T) {
+	tests := []struct {
+		target string
+		in     string
+		out    string
+		fixed  bool
+	}{
+		{"utf8", `<meta charset="gbk"><p>x</p>`, `<meta charset="utf-8"><p>x</p>`, true},
+		{"gbk", `<?xml version="1.0" encoding="UTF-8"?><a/>`, `<?xml version="1.0" encoding="gbk"?><a/>`, true},
+		{"utf-8-sig", "# -*- coding: latin-1 -*-\nx = 1\n", "# -*- coding: utf-8 -*-\nx = 1\n", true},
+		{"utf8", `@charset "UTF-8"; body {}`, `@charset "UTF-8"; body {}`, false},
+		{"utf8", "plain text", "plain text", false},
+	}
+	for _, tt := range tests {
+		c := &trans{options: options{TargetEncoding: tt.target}}
+		r, fixed := c.fixDeclaration("test", bufio.NewReader(strings.NewReader(tt.in)))
+		out, _ := io.ReadAll(r)
+		if string(out) != tt.out || fixed != tt.fixed {
+			t.Errorf("fixDeclaration(%q) to %s = %q, %v; want %q, %v", tt.in, tt.target, out, fixed, tt.out, tt.fixed)
+		}
+	}
+}
+
+// transcode runs a command line with stdin read from a file holding in, and
+// returns what it wrote to stdout.
+func transcode(t *testing.T, in []byte, args ...string) ([]byte, error) {
+	t.Helper()
+	dir := t.TempDir()
+	stdin, stdout := filepath.Join(dir, "stdin"), filepath.Join(dir, "stdout")
+	if err := os.WriteFile(stdin, in, 0644); err != nil {
+		t.Fatal(err)
+	}
+	r, err := os.Open(stdin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	w, err := os.Create(stdout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	defer func(r, w *os.File) { os.Stdin, os.Stdout = r, w }(os.Stdin, os.Stdout)
+	os.Stdin, os.Stdout = r, w
+	defer func(f func() string) { charmapDir = f }(charmapDir)
+	charmapDir = func() string { return "" }
+
+	c := new(cli)
+	ctx, err := c.parser().Parse(args)
+	if err == nil {
+		err = c.run(ctx)
+	}
+	out, exx := os.ReadFile(stdout)
+	if exx != nil {
+		t.Fatal(exx)
+	}
+	return out, err
+}
+
+func quietLog(t *testing.T) {
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+}
+
+// sampleText returns a text in a language the encoding is meant for, taken
+// from the detection corpus.
+func sampleText(t *testing.T, name string) string {
+	t.Helper()
+	files, _ := filepath.Glob(filepath.Join(corpusDir, name, "*-medium.txt"))
+	if len(files) == 0 {
+		t.Fatalf("no corpus sample for %s", name)
+	}
+	f := files[0]
+	if i := slices.IndexFunc(files, func(f string) bool { return !strings.HasPrefix(filepath.Base(f), "en-") }); i >= 0 {
+		f = files[i]
+	}
+	dat, err := os.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := parseEncoding(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := enc.NewDecoder().Bytes(dat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(text)
+}
+
+// TestConvertMatrix converts a sample of every encoding to every other one in
+// place, the result must be the text encoded in the target encoding, or an
+// error with the file left unchanged if the target cannot encode the text.
+func TestConvertMatrix(t *testing.T) {
+	if testing.Short() {
+		t.Skip("converts every pair of encodings")
+	}
+	quietLog(t)
+	var names []string
+	for _, e := range registry {
+		if e.index != "custom" && e.Name != "replacement" {
+			names = append(names, e.Name)
+		}
+	}
+	dir := t.TempDir()
+	for _, source := range names {
+		text := sampleText(t, source)
+		src, _ := parseEncoding(source)
+		in, err := encodeStrict(src, text)
+		if err != nil {
+			t.Fatalf("%s: %s", source, err)
+		}
+		for _, target := range names {
+			dst, _ := parseEncoding(target)
+			want, encodable := encodeStrict(dst, text)
+			f := filepath.Join(dir, source+"-"+target+".txt")
+			if err := os.WriteFile(f, in, 0644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := transcode(t, nil, "-s", source, "-t", target, "-w", f)
+			got, _ := os.ReadFile(f)
+			switch {
+			case encodable != nil && err == nil:
+				t.Errorf("%s to %s: converted text the target cannot encode", source, target)
+			case encodable != nil && !bytes.Equal(got, in):
+				t.Errorf("%s to %s: failed conversion changed the file", source, target)
+			case encodable == nil && err != nil:
+				t.Errorf("%s to %s: %s", source, target, err)
+			case encodable == nil && !bytes.Equal(got, want):
+				t.Errorf("%s to %s: got % x, want % x", source, target, got, want)
+			}
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	quietLog(t)
+	gbk := []byte{0xc4, 0xe3, 0xba, 0xc3, 0xa3, 0xac, 0xca, 0xc0, 0xbd, 0xe7, 0x0a} // 你好，世界
+	sample, err := os.ReadFile(filepath.Join(corpusDir, "gbk", "zh-medium.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, _ := parseEncoding("gbk")
+	text, _ := enc.NewDecoder().String(string(sample))
+	tests := []struct {
+		name  string
+		stdin []byte
+		args  []string
+		out   string
+		err   string
+	}{
+		{"stdin", gbk, []string{"-s", "gbk"}, "你好，世界\n", ""},
+		{"stdin dash", gbk, []string{"-s", "gbk", "-"}, "你好，世界\n", ""},
+		{"auto", sample, nil, text, ""},
+		{"utf-8 bom stripped", []byte("\xef\xbb\xbfabc"), []string{"-s", "utf-8-bom"}, "abc", ""},
+		{"utf-8 bom detected", []byte("\xef\xbb\xbfabc"), nil, "abc", ""},
+		{"utf-8 bom added", []byte("abc"), []string{"-s", "utf8", "-t", "utf-8-bom"}, "\xef\xbb\xbfabc", ""},
+		{"utf-16le bom detected", []byte("\xff\xfea\x00b\x00"), nil, "ab", ""},
+		{"utf-16le bom added", []byte("ab"), []string{"-s", "utf8", "-t", "utf-16le-bom"}, "\xff\xfea\x00b\x00", ""},
+		{"utf-16le without bom", []byte("ab"), []string{"-s", "utf8", "-t", "utf-16le"}, "a\x00b\x00", ""},
+		{"utf-7 signature stripped", []byte("+/v8-hello"), nil, "hello", ""},
+		{"utf-7 signature in base64 run", []byte("+/v9OLQ-"), nil, "中", ""},
+		{"gb18030 signature stripped", []byte("\x84\x31\x95\x33abc"), nil, "abc", ""},
+		{"gb18030 signature added", []byte("abc"), []string{"-s", "utf8", "-t", "gb18030-bom"}, "\x84\x31\x95\x33abc", ""},
+		{"undecodable signature", []byte("\x0e\xfe\xffabc"), nil, "", "unsupported encoding scsu"},
+		{"gbk is gb18030", []byte("€ 𠀀"), []string{"-s", "utf8", "-t", "gbk"}, "\xa2\xe3 \x95\x32\x82\x36", ""},
+		{"empty stdin", nil, nil, "", "cannot determine source-encoding"},
+		{"empty stdin with source", nil, []string{"-s", "gbk"}, "", ""},
+		{"detect", sample, []string{"-d"}, "encoding of file - is gb2312 (language zh, script Hani)\n", ""},
+		{"unencodable", []byte("你好"), []string{"-s", "utf8", "-t", "latin1"}, "", "rune not supported"},
+		{"invalid target", gbk, []string{"-t", "no-such"}, "", "parse target-encoding no-such failed"},
+		{"invalid source", gbk, []string{"-s", "no-such"}, "", "parse source-encoding no-such failed"},
+		{"missing file", nil, []string{"no-such-file.txt"}, "", "no such file"},
+		{"directory", nil, []string{"-s", "gbk", "testfiles"}, "", "not a regular file"},
+		{"unknown language", gbk, []string{"--lang", "xx"}, "", "unknown language xx"},
+		{"unknown candidate", gbk, []string{"--only", "no-such"}, "", "invalid encoding: no-such"},
+		{"invalid zip-names", gbk, []string{"--zip-names", "no-such"}, "", "invalid zip-names encoding: no-such"},
+		{"unknown flag", gbk, []string{"--no-such"}, "", "unknown flag --no-such"},
+	}
+	for _, tt := range tests {
+		out, err := transcode(t, tt.stdin, tt.args...)
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("%s: %s", tt.name, err)
+		case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.err)
+		case string(out) != tt.out:
+			t.Errorf("%s: got %q, want %q", tt.name, out, tt.out)
+		}
+	}
+}
+
+func TestOverwrite(t *testing.T) {
+	quietLog(t)
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		f := filepath.Join(dir, name)
+		if err := os.WriteFile(f, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return f
+	}
+	tests := []struct {
+		name string
+		in   string
+		args []string
+		want string
+	}{
+		{"convert", "\xc4\xe3\xba\xc3", []string{"-s", "gbk"}, "你好"},
+		{"shrink", "\xff\xfea\x00b\x00c\x00", []string{"-s", "utf-16le-bom"}, "abc"},
+		{"grow", "abc", []string{"-s", "utf8", "-t", "utf-32le"}, "a\x00\x00\x00b\x00\x00\x00c\x00\x00\x00"},
+		{"same encoding", "\xc4\xe3\xba\xc3", []string{"-s", "gbk", "-t", "gbk"}, "\xc4\xe3\xba\xc3"},
+		{"empty", "", []string{"-s", "gbk"}, ""},
+	}
+	for _, tt := range tests {
+		f := write(tt.name+".txt", tt.in)
+		out, err := transcode(t, nil, append(tt.args, "-w", f)...)
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		if len(out) > 0 {
+			t.Errorf("%s: wrote %q to stdout", tt.name, out)
+		}
+		if got, _ := os.ReadFile(f); string(got) != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	// files are converted in order, the first failure stops the run
+	a, b, c := write("a.txt", "abc"), write("b.txt", "\xc4\xe3"), write("c.txt", "abc")
+	_, err := transcode(t, nil, "-s", "gbk", "-t", "utf-16le", "-w", a, b, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, b, c = write("a.txt", "abc"), write("b.txt", "\xc4\xe3"), write("c.txt", "abc")
+	_, err = transcode(t, nil, "-s", "gbk", "-t", "latin1", "-w", a, b, c)
+	if err == nil || !strings.Contains(err.Error(), "process "+b) {
+		t.Errorf("got error %v, want failure of %s", err, b)
+	}
+	for f, want := range map[string]string{a: "abc", b: "\xc4\xe3", c: "abc"} {
+		if got, _ := os.ReadFile(f); string(got) != want {
+			t.Errorf("%s: got %q, want %q", f, got, want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package utfx
+
+import (
+	"bytes"
+	"fmt"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+const bom = "\ufeff"
+
+// WithBOM returns enc with a signature, U+FEFF encoded in enc, the way
+// unicode.UTF8BOM is UTF-8 with one. The decoder drops a leading U+FEFF and
+// the encoder writes one. Decoding the signature rather than skipping its
+// bytes matters for UTF-7, where the last base64 digit of +/v8 already
+// carries bits of the next character.
+func WithBOM(enc encoding.Encoding) encoding.Encoding {
+	return withBOM{enc}
+}
+
+type withBOM struct {
+	encoding.Encoding
+}
+
+func (e withBOM) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: transform.Chain(e.Encoding.NewDecoder(), &bomSkipper{})}
+}
+func (e withBOM) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: transform.Chain(&bomWriter{}, e.Encoding.NewEncoder())}
+}
+func (e withBOM) String() string {
+	return fmt.Sprint(e.Encoding) + " with BOM"
+}
+
+// bomSkipper drops U+FEFF at the start of UTF-8 text.
+type bomSkipper struct {
+	started bool
+}
+
+func (t *bomSkipper) Reset() {
+	t.started = false
+}
+func (t *bomSkipper) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	if !t.started {
+		if len(src) < len(bom) && !atEOF && bytes.HasPrefix([]byte(bom), src) {
+			return 0, 0, transform.ErrShortSrc
+		}
+		t.started = true
+		if bytes.HasPrefix(src, []byte(bom)) {
+			nSrc = len(bom)
+		}
+	}
+	n := copy(dst, src[nSrc:])
+	nDst, nSrc = n, nSrc+n
+	if nSrc < len(src) {
+		err = transform.ErrShortDst
+	}
+	return
+}
+
+// bomWriter writes U+FEFF before UTF-8 text.
+type bomWriter struct {
+	started bool
+}
+
+func (t *bomWriter) Reset() {
+	t.started = false
+}
+func (t *bomWriter) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	if !t.started {
+		if len(dst) < len(bom) {
+			return 0, 0, transform.ErrShortDst
+		}
+		nDst = copy(dst, bom)
+		t.started = true
+	}
+	n := copy(dst[nDst:], src)
+	nDst, nSrc = nDst+n, n
+	if nSrc < len(src) {
+		err = transform.ErrShortDst
+	}
+	return
+}
@@ -0,0 +1,158 @@
+package utfx
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// CESU8 is UTF-8 with supplementary characters written as two three-byte
+// surrogates, as in Unicode Technical Report #26.
+var CESU8 encoding.Encoding = cesu8{name: "CESU-8"}
+
+// ModifiedUTF8 is the CESU-8 variant used by Java's DataInput and JNI, which
+// also writes U+0000 as C0 80 so encoded text never contains a zero byte.
+var ModifiedUTF8 encoding.Encoding = cesu8{name: "Modified UTF-8", modified: true}
+
+type cesu8 struct {
+	name     string
+	modified bool
+}
+
+func (c cesu8) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: cesu8Decoder(c)}
+}
+func (c cesu8) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: cesu8Encoder(c)}
+}
+func (c cesu8) String() string {
+	return c.name
+}
+
+// cesu8Decoder also accepts the four-byte UTF-8 form, so plain UTF-8 input
+// decodes unchanged.
+type cesu8Decoder cesu8
+
+func (cesu8Decoder) Reset() {}
+
+func (d cesu8Decoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if nDst+utf8.UTFMax > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		s := src[nSrc:]
+		if c := s[0]; c < utf8.RuneSelf {
+			dst[nDst] = c
+			nDst, nSrc = nDst+1, nSrc+1
+			continue
+		}
+		r, size := utf8.DecodeRune(s)
+		switch {
+		case size > 1:
+		case s[0] == 0xC0 && d.modified:
+			if len(s) < 2 && !atEOF {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			if len(s) >= 2 && s[1] == 0x80 {
+				r, size = 0, 2
+			}
+		case s[0] == 0xED:
+			if !atEOF && incomplete(s) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			r, size = decodeSurrogates(s)
+		default:
+			if !atEOF && !utf8.FullRune(s) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], r)
+		nSrc += size
+	}
+	return
+}
+
+// incomplete reports whether s, starting with ED, is a proper prefix of a
+// three-byte sequence or of a surrogate pair.
+func incomplete(s []byte) bool {
+	switch {
+	case len(s) < 3:
+		return len(s) < 2 || s[1]&0xC0 == 0x80
+	case len(s) < 6:
+		hi := surrogate(s)
+		return hi >= 0xD800 && hi < 0xDC00 &&
+			(len(s) < 4 || s[3] == 0xED) && (len(s) < 5 || s[4] >= 0xB0 && s[4] <= 0xBF)
+	}
+	return false
+}
+
+// surrogate decodes a three-byte surrogate ED [A0-BF] xx, returning -1 if s
+// does not start with one.
+func surrogate(s []byte) rune {
+	if len(s) < 3 || s[0] != 0xED || s[1] < 0xA0 || s[1] > 0xBF || s[2]&0xC0 != 0x80 {
+		return -1
+	}
+	return 0xD000 | rune(s[1]&0x3F)<<6 | rune(s[2]&0x3F)
+}
+
+func decodeSurrogates(s []byte) (rune, int) {
+	hi := surrogate(s)
+	switch {
+	case hi < 0:
+		return utf8.RuneError, 1
+	case hi >= 0xDC00:
+		return utf8.RuneError, 3
+	}
+	lo := surrogate(s[3:])
+	if lo < 0xDC00 {
+		return utf8.RuneError, 3
+	}
+	return utf16.DecodeRune(hi, lo), 6
+}
+
+type cesu8Encoder cesu8
+
+func (cesu8Encoder) Reset() {}
+
+func (e cesu8Encoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		r, size := rune(src[nSrc]), 1
+		if r >= utf8.RuneSelf {
+			if !atEOF && !utf8.FullRune(src[nSrc:]) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			r, size = utf8.DecodeRune(src[nSrc:])
+		}
+		switch {
+		case r == 0 && e.modified:
+			if nDst+2 > len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			dst[nDst], dst[nDst+1] = 0xC0, 0x80
+			nDst += 2
+		case r > 0xFFFF:
+			if nDst+6 > len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			hi, lo := utf16.EncodeRune(r)
+			nDst += encodeSurrogate(dst[nDst:], hi)
+			nDst += encodeSurrogate(dst[nDst:], lo)
+		default:
+			if nDst+utf8.RuneLen(r) > len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += utf8.EncodeRune(dst[nDst:], r)
+		}
+		nSrc += size
+	}
+	return
+}
+
+func encodeSurrogate(dst []byte, s rune) int {
+	dst[0] = 0xED
+	dst[1] = 0x80 | byte(s>>6)&0x3F
+	dst[2] = 0x80 | byte(s)&0x3F
+	return 3
+}
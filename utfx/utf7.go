@@ -0,0 +1,228 @@
+// Package utfx implements the Unicode encoding forms golang.org/x/text does
+// not ship: UTF-7 (RFC 2152), CESU-8 and Java's Modified UTF-8.
+package utfx
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// UTF7 is the UTF-7 encoding of RFC 2152. The encoder writes the optional
+// direct characters as is and always closes a base64 run with '-'.
+var UTF7 encoding.Encoding = utf7{}
+
+type utf7 struct{}
+
+func (utf7) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: &utf7Decoder{}}
+}
+func (utf7) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: &utf7Encoder{}}
+}
+func (utf7) String() string {
+	return "UTF-7"
+}
+
+const base64Chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+var base64Index = func() (t [256]int8) {
+	for i := range t {
+		t[i] = -1
+	}
+	for i := 0; i < len(base64Chars); i++ {
+		t[base64Chars[i]] = int8(i)
+	}
+	return
+}()
+
+// direct reports whether r is written as itself: RFC 2152 sets D and O plus
+// space, tab, CR and LF.
+func direct(r rune) bool {
+	switch {
+	case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+		return true
+	case r >= utf8.RuneSelf:
+		return false
+	}
+	switch r {
+	case '\'', '(', ')', ',', '-', '.', '/', ':', '?', ' ', '\t', '\r', '\n',
+		'!', '"', '#', '$', '%', '&', '*', ';', '<', '=', '>', '@', '[', ']', '^', '_', '`', '{', '|', '}':
+		return true
+	}
+	return false
+}
+
+type utf7Decoder struct {
+	shifted bool
+	fresh   bool // nothing decoded since '+'
+	bits    uint32
+	nbits   uint
+	high    rune // pending high surrogate
+}
+
+func (d *utf7Decoder) Reset() {
+	*d = utf7Decoder{}
+}
+
+func (d *utf7Decoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for ; nSrc < len(src); nSrc++ {
+		if nDst+2*utf8.UTFMax > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		c := src[nSrc]
+		if d.shifted {
+			if v := base64Index[c]; v >= 0 {
+				d.bits = d.bits<<6 | uint32(v)
+				d.nbits += 6
+				d.fresh = false
+				if d.nbits >= 16 {
+					d.nbits -= 16
+					nDst += d.unit(dst[nDst:], rune(d.bits>>d.nbits&0xFFFF))
+				}
+				continue
+			}
+			nDst += d.unshift(dst[nDst:])
+			if c == '-' {
+				if d.fresh {
+					dst[nDst] = '+'
+					nDst++
+				}
+				continue
+			}
+		}
+		switch {
+		case c == '+':
+			d.shifted, d.fresh, d.bits, d.nbits = true, true, 0, 0
+		case c >= utf8.RuneSelf:
+			nDst += utf8.EncodeRune(dst[nDst:], utf8.RuneError)
+		default:
+			dst[nDst] = c
+			nDst++
+		}
+	}
+	if atEOF && d.shifted {
+		if nDst+utf8.UTFMax > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += d.unshift(dst[nDst:])
+	}
+	return
+}
+
+func (d *utf7Decoder) unit(dst []byte, u rune) int {
+	switch {
+	case utf16.IsSurrogate(u) && u < 0xDC00:
+		n := 0
+		if d.high != 0 {
+			n = utf8.EncodeRune(dst, utf8.RuneError)
+		}
+		d.high = u
+		return n
+	case utf16.IsSurrogate(u):
+		r := utf16.DecodeRune(d.high, u)
+		d.high = 0
+		return utf8.EncodeRune(dst, r)
+	case d.high != 0:
+		d.high = 0
+		n := utf8.EncodeRune(dst, utf8.RuneError)
+		return n + utf8.EncodeRune(dst[n:], u)
+	default:
+		return utf8.EncodeRune(dst, u)
+	}
+}
+
+func (d *utf7Decoder) unshift(dst []byte) (n int) {
+	if d.high != 0 {
+		n = utf8.EncodeRune(dst, utf8.RuneError)
+		d.high = 0
+	}
+	d.shifted = false
+	return
+}
+
+type utf7Encoder struct {
+	shifted bool
+	bits    uint32
+	nbits   uint
+}
+
+func (e *utf7Encoder) Reset() {
+	*e = utf7Encoder{}
+}
+
+func (e *utf7Encoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		// a shifted supplementary character takes at most 1+6 bytes, closing a
+		// run and writing a direct character 3.
+		if nDst+8 > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		r, size := rune(src[nSrc]), 1
+		if r >= utf8.RuneSelf {
+			if !atEOF && !utf8.FullRune(src[nSrc:]) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			r, size = utf8.DecodeRune(src[nSrc:])
+		}
+		nSrc += size
+		switch {
+		case direct(r):
+			if e.shifted {
+				nDst += e.unshift(dst[nDst:], base64Index[r] >= 0 || r == '-')
+			}
+			dst[nDst] = byte(r)
+			nDst++
+		case r == '+' && !e.shifted:
+			dst[nDst], dst[nDst+1] = '+', '-'
+			nDst += 2
+		default:
+			if !e.shifted {
+				dst[nDst] = '+'
+				nDst++
+				e.shifted = true
+			}
+			r1, r2 := utf16.EncodeRune(r)
+			if r1 == utf8.RuneError {
+				r1 = r
+			}
+			nDst += e.push(dst[nDst:], r1)
+			if r2 != utf8.RuneError {
+				nDst += e.push(dst[nDst:], r2)
+			}
+		}
+	}
+	if atEOF && e.shifted {
+		if nDst+2 > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += e.unshift(dst[nDst:], true)
+	}
+	return
+}
+
+func (e *utf7Encoder) push(dst []byte, u rune) (n int) {
+	e.bits = e.bits<<16 | uint32(u)
+	e.nbits += 16
+	for e.nbits >= 6 {
+		e.nbits -= 6
+		dst[n] = base64Chars[e.bits>>e.nbits&0x3F]
+		n++
+	}
+	return
+}
+
+func (e *utf7Encoder) unshift(dst []byte, dash bool) (n int) {
+	if e.nbits > 0 {
+		dst[n] = base64Chars[e.bits<<(6-e.nbits)&0x3F]
+		n++
+	}
+	if dash {
+		dst[n] = '-'
+		n++
+	}
+	e.shifted, e.bits, e.nbits = false, 0, 0
+	return
+}
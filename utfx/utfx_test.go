@@ -0,0 +1,145 @@
+package utfx
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+var cases = []struct {
+	enc     encoding.Encoding
+	text    string
+	encoded string
+}{
+	{UTF7, "Hi Mom -☺-!", "Hi Mom -+Jjo--!"},
+	{UTF7, "日本語", "+ZeVnLIqe-"},
+	{UTF7, "A≢Α.", "A+ImIDkQ."},
+	{UTF7, "1 + 1 = 2", "1 +- 1 = 2"},
+	{UTF7, "~\\", "+AH4AXA-"},
+	{UTF7, "😀", "+2D3eAA-"},
+	{UTF7, "a😀b", "a+2D3eAA-b"},
+	{CESU8, "a€😀", "a\xe2\x82\xac\xed\xa0\xbd\xed\xb8\x80"},
+	{CESU8, "\x00", "\x00"},
+	{ModifiedUTF8, "a\x00😀", "a\xc0\x80\xed\xa0\xbd\xed\xb8\x80"},
+	{WithBOM(UTF7), "Hi ☺", "+/v8-Hi +Jjo-"},
+	{WithBOM(CESU8), "", "\xef\xbb\xbf"},
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, c := range cases {
+		got, err := c.enc.NewEncoder().String(c.text)
+		if err != nil || got != c.encoded {
+			t.Errorf("%s: encode %q got %q (%v), want %q", c.enc, c.text, got, err, c.encoded)
+		}
+		got, err = c.enc.NewDecoder().String(c.encoded)
+		if err != nil || got != c.text {
+			t.Errorf("%s: decode %q got %q (%v), want %q", c.enc, c.encoded, got, err, c.text)
+		}
+	}
+}
+
+// TestShortBuffers feeds input one byte at a time so every split point of a
+// multi-byte sequence or base64 run is exercised.
+func TestShortBuffers(t *testing.T) {
+	for _, c := range cases {
+		var out bytes.Buffer
+		r := transform.NewReader(iotest.OneByteReader(strings.NewReader(c.encoded)), c.enc.NewDecoder())
+		if _, err := out.ReadFrom(iotest.OneByteReader(r)); err != nil || out.String() != c.text {
+			t.Errorf("%s: decode %q got %q (%v), want %q", c.enc, c.encoded, out.String(), err, c.text)
+		}
+		out.Reset()
+		w := transform.NewWriter(&out, c.enc.NewEncoder())
+		for i := 0; i < len(c.text); i++ {
+			w.Write([]byte{c.text[i]})
+		}
+		if err := w.Close(); err != nil || out.String() != c.encoded {
+			t.Errorf("%s: encode %q got %q (%v), want %q", c.enc, c.text, out.String(), err, c.encoded)
+		}
+	}
+}
+
+func TestWithBOM(t *testing.T) {
+	var cases = []struct {
+		enc     encoding.Encoding
+		encoded string
+		want    string
+	}{
+		{WithBOM(UTF7), "+/v8-a", "a"},
+		{WithBOM(UTF7), "+/v9OLQ-", "中"},
+		{WithBOM(UTF7), "Hi", "Hi"},
+		{WithBOM(UTF7), "+/v8-+/v8-", "\ufeff"},
+	}
+	for _, c := range cases {
+		if got, err := c.enc.NewDecoder().String(c.encoded); err != nil || got != c.want {
+			t.Errorf("%s: decode %q got %q (%v), want %q", c.enc, c.encoded, got, err, c.want)
+		}
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	var cases = []struct {
+		enc     encoding.Encoding
+		encoded string
+		want    string
+	}{
+		{UTF7, "+2D0-x", "�x"},
+		{UTF7, "+3gA-", "�"},
+		{UTF7, "a\xffb", "a�b"},
+		{CESU8, "\xed\xa0\xbdx", "�x"},
+		{CESU8, "\xed\xb8\x80", "�"},
+		{CESU8, "\xc0\x80", "��"},
+		{CESU8, "\xf0\x9f\x98\x80", "😀"},
+		{ModifiedUTF8, "\xc0", "�"},
+	}
+	for _, c := range cases {
+		got, err := c.enc.NewDecoder().String(c.encoded)
+		if err != nil || got != c.want {
+			t.Errorf("%s: decode %q got %q (%v), want %q", c.enc, c.encoded, got, err, c.want)
+		}
+	}
+}
+
+// FuzzDecodeEncode decodes arbitrary bytes, which must give valid UTF-8 that
+// encodes and decodes back to itself, in one go or a byte at a time.
+func FuzzDecodeEncode(f *testing.F) {
+	for _, c := range cases {
+		f.Add([]byte(c.encoded))
+		f.Add([]byte(c.text))
+	}
+	for _, dir := range []string{"utf-7", "cesu-8", "modified-utf-8"} {
+		files, _ := filepath.Glob(filepath.Join("..", "testfiles", "corpus", dir, "*-short.txt"))
+		for _, file := range files {
+			if dat, err := os.ReadFile(file); err == nil {
+				f.Add(dat)
+			}
+		}
+	}
+	f.Fuzz(func(t *testing.T, dat []byte) {
+		for _, enc := range []encoding.Encoding{UTF7, CESU8, ModifiedUTF8} {
+			text, err := enc.NewDecoder().Bytes(dat)
+			if err != nil || !utf8.Valid(text) {
+				t.Fatalf("%s: decode %q got %q (%v)", enc, dat, text, err)
+			}
+			var out bytes.Buffer
+			r := transform.NewReader(iotest.OneByteReader(bytes.NewReader(dat)), enc.NewDecoder())
+			if _, err := out.ReadFrom(r); err != nil || !bytes.Equal(out.Bytes(), text) {
+				t.Errorf("%s: decode %q byte by byte got %q (%v), want %q", enc, dat, out.Bytes(), err, text)
+			}
+			encoded, err := enc.NewEncoder().Bytes(text)
+			if err != nil {
+				t.Fatalf("%s: encode %q: %v", enc, text, err)
+			}
+			back, err := enc.NewDecoder().Bytes(encoded)
+			if err != nil || !bytes.Equal(back, text) {
+				t.Errorf("%s: %q encoded as %q decodes to %q (%v)", enc, text, encoded, back, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,319 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"golang.org/x/text/encoding"
+)
+
+// watcher converts files as they land in a directory, once they stopped
+// changing. A state file remembers the files converted, so restarts and the
+// events of in-place conversion do not convert them again.
+type watcher struct {
+	SourceEncoding string        `short:"s" name:"source-encoding" default:"auto" help:"Set source encoding, default as auto-detection."`
+	TargetEncoding string        `short:"t" name:"target-encoding" default:"utf8" help:"Set target encoding, default as utf8."`
+	FixDeclaration bool          `name:"fix-declarations" help:"Rewrite in-band charset declarations (HTML, XML, CSS, coding cookies) to the target encoding."`
+	Output         string        `short:"o" name:"output" type:"path" help:"Write converted files to this directory under their relative path, default converts in place."`
+	Include        []string      `name:"include" help:"Only convert files with names matching these patterns, like *.csv."`
+	Debounce       time.Duration `name:"debounce" default:"2s" help:"Convert a file once it has not changed for this long."`
+	State          string        `name:"state" type:"path" help:"State file, default .transcode-watch.json in the output directory or DIR."`
+	Dir            string        `arg:"" type:"existingdir" help:"Directory to watch, with its subdirectories."`
+	detection
+
+	target encoding.Encoding
+	done   func(rel string, err error) // called after each conversion
+}
+
+const watchStateName = ".transcode-watch.json"
+
+// watchState maps the relative path of converted files to their hash, after
+// conversion for files converted in place.
+type watchState struct {
+	path  string
+	Files map[string]watchedFile `json:"files"`
+}
+
+type watchedFile struct {
+	SHA256 string `json:"sha256"`
+	Error  string `json:"error,omitempty"`
+}
+
+type pendingFile struct {
+	timer *time.Timer
+	size  int64
+	mtime time.Time
+}
+
+func (c *watcher) Run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return c.watch(ctx)
+}
+
+func (c *watcher) watch(ctx context.Context) (err error) {
+	if err = c.detection.check(); err != nil {
+		return
+	}
+	if c.target, err = parseEncoding(c.TargetEncoding); err != nil {
+		return fmt.Errorf("parse target-encoding %s failed: %w", c.TargetEncoding, err)
+	}
+	if c.Dir, err = filepath.Abs(c.Dir); err != nil {
+		return
+	}
+	if c.Output != "" {
+		if c.Output, err = filepath.Abs(c.Output); err != nil {
+			return
+		}
+		if err = os.MkdirAll(c.Output, 0755); err != nil {
+			return
+		}
+	}
+	if c.State == "" {
+		c.State = filepath.Join(cmp.Or(c.Output, c.Dir), watchStateName)
+	}
+	state, err := loadWatchState(c.State)
+	if err != nil {
+		return
+	}
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return
+	}
+	defer w.Close()
+
+	ready := make(chan string)
+	pending := make(map[string]*pendingFile)
+	schedule := func(p string) {
+		st, err := os.Stat(p)
+		if err != nil || !st.Mode().IsRegular() {
+			return
+		}
+		if f, ok := pending[p]; ok {
+			f.size, f.mtime = st.Size(), st.ModTime()
+			f.timer.Reset(c.Debounce)
+			return
+		}
+		pending[p] = &pendingFile{
+			timer: time.AfterFunc(c.Debounce, func() {
+				select {
+				case ready <- p:
+				case <-ctx.Done():
+				}
+			}),
+			size:  st.Size(),
+			mtime: st.ModTime(),
+		}
+	}
+	addTree := func(dir string) error {
+		return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			switch {
+			case err != nil:
+				return err
+			case d.IsDir() && p != dir && c.ignored(p):
+				return filepath.SkipDir
+			case d.IsDir():
+				return w.Add(p)
+			case c.wanted(p):
+				schedule(p)
+			}
+			return nil
+		})
+	}
+	if err = addTree(c.Dir); err != nil {
+		return
+	}
+	log.Printf("watching %s", c.Dir)
+
+	for {
+		select {
+		case <-ctx.Done():
+			for _, f := range pending {
+				f.timer.Stop()
+			}
+			return nil
+		case ev, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			if !ev.Has(fsnotify.Create) && !ev.Has(fsnotify.Write) {
+				continue
+			}
+			if st, err := os.Stat(ev.Name); err == nil && st.IsDir() {
+				if !c.ignored(ev.Name) {
+					if err = addTree(ev.Name); err != nil {
+						log.Printf("watch %s failed: %s", ev.Name, err)
+					}
+				}
+			} else if c.wanted(ev.Name) {
+				schedule(ev.Name)
+			}
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+			log.Printf("watch error: %s", err)
+		case p := <-ready:
+			f, ok := pending[p]
+			if !ok {
+				continue // fired again while the last firing was handled
+			}
+			st, err := os.Stat(p)
+			if err == nil && (st.Size() != f.size || !st.ModTime().Equal(f.mtime)) {
+				schedule(p) // still being written
+				continue
+			}
+			delete(pending, p)
+			if err == nil {
+				c.convert(p, state)
+			}
+		}
+	}
+}
+
+// ignored reports hidden files and directories and the output directory.
+func (c *watcher) ignored(p string) bool {
+	return strings.HasPrefix(filepath.Base(p), ".") || (c.Output != "" && p == c.Output)
+}
+
+func (c *watcher) wanted(p string) bool {
+	if c.ignored(p) || p == c.State {
+		return false
+	}
+	if c.Output != "" && strings.HasPrefix(p, c.Output+string(filepath.Separator)) {
+		return false
+	}
+	if len(c.Include) == 0 {
+		return true
+	}
+	for _, pattern := range c.Include {
+		if ok, _ := filepath.Match(pattern, filepath.Base(p)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// convert converts a file unless the state has it with the same hash.
+func (c *watcher) convert(p string, state *watchState) {
+	rel, _ := filepath.Rel(c.Dir, p)
+	hash, err := fileHash(p)
+	if err != nil || state.Files[rel].SHA256 == hash {
+		return
+	}
+	t := &trans{
+		options: options{
+			SourceEncoding: c.SourceEncoding,
+			TargetEncoding: c.TargetEncoding,
+			FixDeclaration: c.FixDeclaration,
+			Overwrite:      c.Output == "",
+			detection:      c.detection,
+		},
+		target: c.target,
+	}
+	if c.Output == "" {
+		err = t.proc(p)
+		if h, exx := fileHash(p); exx == nil {
+			hash = h
+		}
+	} else {
+		err = c.convertTo(t, p, filepath.Join(c.Output, rel))
+	}
+	entry := watchedFile{SHA256: hash}
+	if err != nil {
+		entry.Error = err.Error()
+		log.Printf("convert %s failed: %s", p, err)
+	} else {
+		log.Printf("converted %s", p)
+	}
+	state.Files[rel] = entry
+	if exx := state.save(); exx != nil {
+		log.Printf("save state %s failed: %s", state.path, exx)
+	}
+	if c.done != nil {
+		c.done(rel, err)
+	}
+}
+
+// convertTo writes the converted file next to dest first, so readers of the
+// output directory never see a partial file.
+func (c *watcher) convertTo(t *trans, p, dest string) (err error) {
+	if err = os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(dest), ".transcode.*")
+	if err != nil {
+		return
+	}
+	t.output = tmp
+	err = t.proc(p)
+	if exx := tmp.Close(); err == nil {
+		err = exx
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), dest)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return
+}
+
+func fileHash(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func loadWatchState(p string) (*watchState, error) {
+	s := &watchState{path: p, Files: make(map[string]watchedFile)}
+	dat, err := os.ReadFile(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err == nil {
+		err = json.Unmarshal(dat, s)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read state file %s failed: %w", p, err)
+	}
+	if s.Files == nil {
+		s.Files = make(map[string]watchedFile)
+	}
+	return s, nil
+}
+
+func (s *watchState) save() error {
+	dat, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err = os.WriteFile(tmp, dat, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// startWatch runs c until the test ends and returns the files it converts.
+func startWatch(t *testing.T, c *watcher) <-chan string {
+	t.Helper()
+	converted := make(chan string, 16)
+	c.done = func(rel string, err error) {
+		if err != nil {
+			t.Errorf("convert %s: %s", rel, err)
+		}
+		converted <- rel
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		if err := c.watch(ctx); err != nil {
+			t.Error(err)
+		}
+	}()
+	t.Cleanup(func() { cancel(); <-stopped })
+	return converted
+}
+
+func expectConverted(t *testing.T, converted <-chan string, want ...string) {
+	t.Helper()
+	got := make(map[string]bool)
+	for range want {
+		select {
+		case rel := <-converted:
+			got[rel] = true
+		case <-time.After(10 * time.Second):
+			t.Fatalf("converted %v, want %v", got, want)
+		}
+	}
+	for _, rel := range want {
+		if !got[rel] {
+			t.Errorf("converted %v, want %v", got, want)
+		}
+	}
+	select {
+	case rel := <-converted:
+		t.Errorf("converted %s again", rel)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func newWatcher(dir, output string) *watcher {
+	return &watcher{
+		Dir:            dir,
+		Output:         output,
+		SourceEncoding: "auto",
+		TargetEncoding: "utf8",
+		Debounce:       50 * time.Millisecond,
+		detection:      detection{TrustDeclared: "verify"},
+	}
+}
+
+func TestWatch(t *testing.T) {
+	quietLog(t)
+	gbk, err := os.ReadFile(filepath.Join(corpusDir, "gbk", "zh-medium.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	koi8, err := os.ReadFile(filepath.Join(corpusDir, "koi8-r", "ru-medium.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	zh, _ := os.ReadFile(filepath.Join("testfiles", "texts", "zh.txt"))
+	ru, _ := os.ReadFile(filepath.Join("testfiles", "texts", "ru.txt"))
+
+	dir, out := t.TempDir(), t.TempDir()
+	os.WriteFile(filepath.Join(dir, "old.txt"), gbk, 0644)
+	os.WriteFile(filepath.Join(dir, ".partial"), gbk, 0644)
+	converted := startWatch(t, newWatcher(dir, out))
+	expectConverted(t, converted, "old.txt")
+
+	os.Mkdir(filepath.Join(dir, "sub"), 0755)
+	time.Sleep(100 * time.Millisecond)
+	f, _ := os.Create(filepath.Join(dir, "sub", "new.txt"))
+	for i := 0; i < len(koi8); i += 100 {
+		f.Write(koi8[i:min(i+100, len(koi8))])
+		time.Sleep(10 * time.Millisecond)
+	}
+	f.Close()
+	expectConverted(t, converted, filepath.Join("sub", "new.txt"))
+
+	for rel, want := range map[string]string{"old.txt": string(zh), filepath.Join("sub", "new.txt"): string(ru)} {
+		if got, _ := os.ReadFile(filepath.Join(out, rel)); string(got) != want {
+			t.Errorf("%s: got %q, want %q", rel, got, want)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(out, ".partial")); err == nil {
+		t.Error("converted a hidden file")
+	}
+
+	// rewriting the same content or restarting converts nothing
+	os.WriteFile(filepath.Join(dir, "old.txt"), gbk, 0644)
+	converted = startWatch(t, newWatcher(dir, out))
+	expectConverted(t, converted)
+}
+
+func TestWatchInPlace(t *testing.T) {
+	quietLog(t)
+	gbk, err := os.ReadFile(filepath.Join(corpusDir, "gbk", "zh-medium.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	c := newWatcher(dir, "")
+	c.Include = []string{"*.csv"}
+	converted := startWatch(t, c)
+	os.WriteFile(filepath.Join(dir, "a.csv"), gbk, 0644)
+	os.WriteFile(filepath.Join(dir, "a.txt"), gbk, 0644)
+	expectConverted(t, converted, "a.csv")
+
+	enc, _ := parseEncoding("gbk")
+	want, _ := enc.NewDecoder().Bytes(gbk)
+	if got, _ := os.ReadFile(filepath.Join(dir, "a.csv")); string(got) != string(want) {
+		t.Errorf("a.csv: got %q, want %q", got, want)
+	}
+	if got, _ := os.ReadFile(filepath.Join(dir, "a.txt")); string(got) != string(gbk) {
+		t.Error("a.txt does not match --include but was converted")
+	}
+	state, err := loadWatchState(filepath.Join(dir, watchStateName))
+	if err != nil || len(state.Files) != 1 {
+		t.Errorf("state %+v (%v), want a.csv", state, err)
+	}
+}